@@ -21,7 +21,10 @@ import (
 	cli "github.com/jawher/mow.cli"
 )
 
-const appDescription = "This service reads people from Neo4j"
+const (
+	appDescription = "This service reads people from Neo4j"
+	writeTimeout   = 10 * time.Second
+)
 
 func main() {
 	app := cli.App("public-people-api", "A public RESTful API for accessing People in neo4j")
@@ -55,6 +58,12 @@ func main() {
 		Desc:   "Duration Get requests should be cached for. e.g. 2h45m would set the max-age value to '7440' seconds",
 		EnvVar: "CACHE_DURATION",
 	})
+	cacheSize := app.Int(cli.IntOpt{
+		Name:   "cache-size",
+		Value:  1000,
+		Desc:   "Maximum number of people kept in the in-process cache for the cache duration. 0 disables the cache",
+		EnvVar: "CACHE_SIZE",
+	})
 	requestLoggingEnabled := app.Bool(cli.BoolOpt{
 		Name:   "requestLoggingEnabled",
 		Value:  true,
@@ -68,6 +77,55 @@ func main() {
 		EnvVar: "CONCEPTS_API",
 	})
 
+	upstreamRetries := app.Int(cli.IntOpt{
+		Name:   "upstream-retries",
+		Value:  2,
+		Desc:   "How many times a request to the concepts API is retried on a connection error or a 502, 503 or 504",
+		EnvVar: "UPSTREAM_RETRIES",
+	})
+	upstreamRetryBackoff := app.String(cli.StringOpt{
+		Name:   "upstream-retry-backoff",
+		Value:  "100ms",
+		Desc:   "Initial wait before retrying a request to the concepts API, doubled for each further retry",
+		EnvVar: "UPSTREAM_RETRY_BACKOFF",
+	})
+	breakerThreshold := app.Int(cli.IntOpt{
+		Name:   "circuit-breaker-threshold",
+		Value:  5,
+		Desc:   "Number of consecutive failed requests to the concepts API that opens the circuit breaker. 0 disables the breaker",
+		EnvVar: "CIRCUIT_BREAKER_THRESHOLD",
+	})
+	breakerCooldown := app.String(cli.StringOpt{
+		Name:   "circuit-breaker-cooldown",
+		Value:  "10s",
+		Desc:   "How long the circuit breaker stays open before letting a trial request through to the concepts API",
+		EnvVar: "CIRCUIT_BREAKER_COOLDOWN",
+	})
+	upstreamTimeout := app.String(cli.StringOpt{
+		Name:   "upstream-timeout",
+		Value:  "8s",
+		Desc:   "Maximum time spent retrieving a person from the concepts API, retries included. Must be shorter than the 10s server write timeout",
+		EnvVar: "UPSTREAM_TIMEOUT",
+	})
+	redirectMode := app.String(cli.StringOpt{
+		Name:   "redirect-mode",
+		Value:  "301",
+		Desc:   "How people requested by a concorded UUID are served: 301 or 308 to redirect to the canonical person, or inline to serve it directly. Can be overridden per request with the redirect query parameter",
+		EnvVar: "REDIRECT_MODE",
+	})
+	imageURLTemplate := app.String(cli.StringOpt{
+		Name:   "image-url-template",
+		Value:  "https://www.ft.com/__origami/service/image/v2/images/raw/{url}?source=public-people-api&width={width}&height={height}&format={format}&fit=cover",
+		Desc:   "URL of an image rendition in the image service, with {url}, {width}, {height} and {format} placeholders. Empty disables image sets",
+		EnvVar: "IMAGE_URL_TEMPLATE",
+	})
+	imageRenditions := app.String(cli.StringOpt{
+		Name:   "image-renditions",
+		Value:  "150x150.jpg,300x300.jpg,600x600.jpg",
+		Desc:   "Comma separated renditions served in the image set of a person, each of the form WIDTHxHEIGHT.FORMAT",
+		EnvVar: "IMAGE_RENDITIONS",
+	})
+
 	logger.InitLogger(*appSystemCode, *logLevel)
 	logger.Infof("[Startup] public-people-api is starting ")
 
@@ -85,6 +143,29 @@ func main() {
 		if durationErr != nil {
 			logger.Fatalf("Failed to parse cache duration string, %v", durationErr)
 		}
+		retryBackoff, durationErr := time.ParseDuration(*upstreamRetryBackoff)
+		if durationErr != nil {
+			logger.Fatalf("Failed to parse upstream retry backoff string, %v", durationErr)
+		}
+		cooldown, durationErr := time.ParseDuration(*breakerCooldown)
+		if durationErr != nil {
+			logger.Fatalf("Failed to parse circuit breaker cooldown string, %v", durationErr)
+		}
+		timeout, durationErr := time.ParseDuration(*upstreamTimeout)
+		if durationErr != nil {
+			logger.Fatalf("Failed to parse upstream timeout string, %v", durationErr)
+		}
+		if timeout >= writeTimeout {
+			logger.Fatalf("Upstream timeout %v must be shorter than the server write timeout %v", timeout, writeTimeout)
+		}
+		mode, err := people.ParseRedirectMode(*redirectMode)
+		if err != nil {
+			logger.Fatalf("Failed to parse redirect mode, %v", err)
+		}
+		renditions, err := people.ParseImageRenditions(*imageRenditions)
+		if err != nil {
+			logger.Fatalf("Failed to parse image renditions, %v", err)
+		}
 
 		c := &http.Client{
 			Transport: &http.Transport{
@@ -102,10 +183,22 @@ func main() {
 				MaxIdleConnsPerHost:   20,
 			},
 		}
-		handler := people.NewHandler(cacheDuration, *publicConceptsApiURL, c)
+		handler := people.NewHandler(people.HandlerConfig{
+			CacheDuration:        cacheDuration,
+			PublicConceptsApiURL: *publicConceptsApiURL,
+			CacheSize:            *cacheSize,
+			UpstreamRetries:      *upstreamRetries,
+			UpstreamRetryBackoff: retryBackoff,
+			BreakerThreshold:     *breakerThreshold,
+			BreakerCooldown:      cooldown,
+			UpstreamTimeout:      timeout,
+			RedirectMode:         mode,
+			ImageURLTemplate:     *imageURLTemplate,
+			ImageRenditions:      renditions,
+		}, c)
 
 		router := mux.NewRouter()
-		healthCheckService := people.NewHealthCheckService([]v1_1.Check{handler.Healthchecks()}, appConfig)
+		healthCheckService := people.NewHealthCheckService([]v1_1.Check{handler.Healthchecks(), handler.CircuitBreakerCheck()}, appConfig)
 
 		handler.RegisterHandlers(router)
 		r := healthCheckService.RegisterAdminHandlers(router)
@@ -113,11 +206,11 @@ func main() {
 		httpServer := &http.Server{
 			Addr:         fmt.Sprintf("0.0.0.0:%s", *port),
 			ReadTimeout:  10 * time.Second,
-			WriteTimeout: 10 * time.Second,
+			WriteTimeout: writeTimeout,
 		}
 		httpServer.Handler = r
 
-		sig := make(chan os.Signal)
+		sig := make(chan os.Signal, 1)
 		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
 
 		go func() {
@@ -0,0 +1,148 @@
+package people
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+)
+
+var errCircuitOpen = errors.New("circuit breaker for public-concepts-api is open")
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// circuitBreaker stops calls to the concepts API after threshold consecutive failed requests.
+// Once cooldown has passed a single trial call is let through, and its outcome decides
+// whether the breaker closes again or stays open for another cooldown.
+// A nil *circuitBreaker never opens.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	state     breakerState
+	failures  int
+	openedAt  time.Time
+	trial     bool
+	now       func() time.Time
+
+	opened metrics.Counter
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		opened:    metrics.GetOrRegisterCounter("people.upstream.breaker.opened", metrics.DefaultRegistry),
+	}
+}
+
+// allow returns errCircuitOpen if a call to the concepts API should not be made
+func (b *circuitBreaker) allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return errCircuitOpen
+		}
+		b.state = breakerHalfOpen
+		b.trial = true
+		return nil
+	case breakerHalfOpen:
+		if b.trial {
+			return errCircuitOpen
+		}
+		b.trial = true
+		return nil
+	}
+	return nil
+}
+
+func (b *circuitBreaker) success() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = breakerClosed
+	b.failures = 0
+	b.trial = false
+}
+
+// cancelled records a request given up on by its caller, which tells nothing about the concepts API.
+// If it was the trial request of a half-open breaker, the next request is let through as the trial instead.
+func (b *circuitBreaker) cancelled() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.trial = false
+	}
+}
+
+func (b *circuitBreaker) failure() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.threshold {
+		if b.state != breakerOpen {
+			b.opened.Inc(1)
+		}
+		b.state = breakerOpen
+		b.openedAt = b.now()
+		b.trial = false
+	}
+}
+
+// Checker reports an error while the breaker is open. It passes again once the cooldown is over,
+// as only a trial request can close the breaker and failing would keep requests away from it.
+func (b *circuitBreaker) Checker() (string, error) {
+	if b == nil {
+		return "Circuit breaker is disabled", nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case b.state == breakerOpen && b.now().Sub(b.openedAt) < b.cooldown:
+		return "", fmt.Errorf("circuit breaker is %s after %d consecutive failures calling public-concepts-api", b.state, b.failures)
+	case b.state != breakerClosed:
+		return fmt.Sprintf("Circuit breaker is %s after %d consecutive failures, letting a trial request through", breakerHalfOpen, b.failures), nil
+	}
+	return "Circuit breaker is closed", nil
+}
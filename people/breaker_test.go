@@ -0,0 +1,114 @@
+package people
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker_OpensAfterThreshold(t *testing.T) {
+	b := newCircuitBreaker(3, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, b.allow())
+		b.failure()
+	}
+	_, err := b.Checker()
+	assert.NoError(t, err)
+
+	assert.NoError(t, b.allow())
+	b.failure()
+
+	assert.Equal(t, errCircuitOpen, b.allow())
+	_, err = b.Checker()
+	assert.EqualError(t, err, "circuit breaker is open after 3 consecutive failures calling public-concepts-api")
+}
+
+func TestCircuitBreaker_SuccessResetsFailures(t *testing.T) {
+	b := newCircuitBreaker(2, time.Minute)
+
+	b.failure()
+	b.success()
+	b.failure()
+
+	assert.NoError(t, b.allow())
+}
+
+func TestCircuitBreaker_HalfOpenTrial(t *testing.T) {
+	b := newCircuitBreaker(1, time.Minute)
+	now := time.Now()
+	b.now = func() time.Time { return now }
+
+	b.failure()
+	assert.Equal(t, errCircuitOpen, b.allow())
+
+	now = now.Add(2 * time.Minute)
+	assert.NoError(t, b.allow(), "a trial request should be let through after the cooldown")
+	assert.Equal(t, errCircuitOpen, b.allow(), "only one trial request should be let through")
+
+	b.failure()
+	assert.Equal(t, errCircuitOpen, b.allow(), "a failed trial should reopen the breaker")
+
+	now = now.Add(2 * time.Minute)
+	assert.NoError(t, b.allow())
+	b.success()
+	assert.NoError(t, b.allow())
+	assert.NoError(t, b.allow())
+	msg, err := b.Checker()
+	assert.NoError(t, err)
+	assert.Equal(t, "Circuit breaker is closed", msg)
+}
+
+func TestCircuitBreaker_Disabled(t *testing.T) {
+	b := newCircuitBreaker(0, time.Minute)
+	assert.Nil(t, b)
+
+	b.failure()
+	assert.NoError(t, b.allow())
+	_, err := b.Checker()
+	assert.NoError(t, err)
+}
+
+func TestCircuitBreaker_CheckerPassesAfterCooldown(t *testing.T) {
+	b := newCircuitBreaker(1, time.Minute)
+	now := time.Now()
+	b.now = func() time.Time { return now }
+
+	b.failure()
+	_, err := b.Checker()
+	assert.Error(t, err)
+
+	now = now.Add(2 * time.Minute)
+	msg, err := b.Checker()
+	assert.NoError(t, err, "the breaker is due a trial request, so requests must not be kept away from it")
+	assert.Equal(t, "Circuit breaker is half-open after 1 consecutive failures, letting a trial request through", msg)
+
+	assert.NoError(t, b.allow())
+	_, err = b.Checker()
+	assert.NoError(t, err)
+
+	b.failure()
+	_, err = b.Checker()
+	assert.Error(t, err, "a failed trial should fail the check again until the next cooldown is over")
+}
+
+func TestCircuitBreaker_CancelledTrial(t *testing.T) {
+	b := newCircuitBreaker(1, time.Minute)
+	now := time.Now()
+	b.now = func() time.Time { return now }
+
+	b.failure()
+	now = now.Add(2 * time.Minute)
+	assert.NoError(t, b.allow())
+	assert.Equal(t, errCircuitOpen, b.allow())
+
+	b.cancelled()
+	assert.NoError(t, b.allow(), "the next request should be the trial once the trial is cancelled")
+	assert.Equal(t, errCircuitOpen, b.allow())
+
+	b.success()
+	b.cancelled()
+	assert.NoError(t, b.allow())
+	assert.NoError(t, b.allow())
+}
@@ -0,0 +1,104 @@
+package people
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+)
+
+// cachedPerson is the outcome of a successful lookup. Not found results are cached too,
+// so that repeated requests for unknown UUIDs do not reach the concepts API.
+type cachedPerson struct {
+	person Person
+	found  bool
+}
+
+type cacheEntry struct {
+	uuid    string
+	value   cachedPerson
+	expires time.Time
+}
+
+// personCache is a bounded LRU cache of converted people whose entries expire after a TTL.
+// A nil *personCache is a valid, always empty, cache.
+type personCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[string]*list.Element
+	order   *list.List
+	now     func() time.Time
+
+	hits      metrics.Counter
+	misses    metrics.Counter
+	evictions metrics.Counter
+}
+
+func newPersonCache(size int, ttl time.Duration) *personCache {
+	if size <= 0 || ttl <= 0 {
+		return nil
+	}
+	return &personCache{
+		size:      size,
+		ttl:       ttl,
+		entries:   make(map[string]*list.Element, size),
+		order:     list.New(),
+		now:       time.Now,
+		hits:      metrics.GetOrRegisterCounter("people.cache.hits", metrics.DefaultRegistry),
+		misses:    metrics.GetOrRegisterCounter("people.cache.misses", metrics.DefaultRegistry),
+		evictions: metrics.GetOrRegisterCounter("people.cache.evictions", metrics.DefaultRegistry),
+	}
+}
+
+func (c *personCache) get(uuid string) (cachedPerson, bool) {
+	if c == nil {
+		return cachedPerson{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[uuid]
+	if !ok {
+		c.misses.Inc(1)
+		return cachedPerson{}, false
+	}
+	entry := el.Value.(*cacheEntry)
+	if c.now().After(entry.expires) {
+		c.remove(el)
+		c.misses.Inc(1)
+		return cachedPerson{}, false
+	}
+	c.order.MoveToFront(el)
+	c.hits.Inc(1)
+	return entry.value, true
+}
+
+func (c *personCache) set(uuid string, value cachedPerson) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires := c.now().Add(c.ttl)
+	if el, ok := c.entries[uuid]; ok {
+		entry := el.Value.(*cacheEntry)
+		entry.value = value
+		entry.expires = expires
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[uuid] = c.order.PushFront(&cacheEntry{uuid: uuid, value: value, expires: expires})
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+		c.evictions.Inc(1)
+	}
+}
+
+func (c *personCache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*cacheEntry).uuid)
+}
@@ -0,0 +1,67 @@
+package people
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersonCache_GetSet(t *testing.T) {
+	c := newPersonCache(2, time.Minute)
+
+	_, ok := c.get("a")
+	assert.False(t, ok)
+
+	c.set("a", cachedPerson{person: Person{Thing: Thing{PrefLabel: "A"}}, found: true})
+	c.set("b", cachedPerson{found: false})
+
+	a, ok := c.get("a")
+	assert.True(t, ok)
+	assert.True(t, a.found)
+	assert.Equal(t, "A", a.person.PrefLabel)
+
+	b, ok := c.get("b")
+	assert.True(t, ok)
+	assert.False(t, b.found)
+}
+
+func TestPersonCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := newPersonCache(2, time.Minute)
+
+	c.set("a", cachedPerson{found: true})
+	c.set("b", cachedPerson{found: true})
+	c.get("a")
+	c.set("c", cachedPerson{found: true})
+
+	_, ok := c.get("b")
+	assert.False(t, ok, "b was least recently used and should have been evicted")
+	_, ok = c.get("a")
+	assert.True(t, ok)
+	_, ok = c.get("c")
+	assert.True(t, ok)
+}
+
+func TestPersonCache_Expires(t *testing.T) {
+	c := newPersonCache(2, time.Minute)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	c.set("a", cachedPerson{found: true})
+	_, ok := c.get("a")
+	assert.True(t, ok)
+
+	now = now.Add(2 * time.Minute)
+	_, ok = c.get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.order.Len())
+}
+
+func TestPersonCache_Disabled(t *testing.T) {
+	c := newPersonCache(0, time.Minute)
+	assert.Nil(t, c)
+
+	c.set("a", cachedPerson{found: true})
+	_, ok := c.get("a")
+	assert.False(t, ok)
+}
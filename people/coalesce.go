@@ -0,0 +1,101 @@
+package people
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"github.com/Financial-Times/go-logger"
+	"github.com/rcrowley/go-metrics"
+)
+
+// errLookupPanicked is returned to every caller of a lookup that panicked
+var errLookupPanicked = errors.New("lookup of person panicked")
+
+// flight is an upstream lookup that is in progress, or has just completed
+type flight struct {
+	tid     string
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+	value   cachedPerson
+	err     error
+}
+
+// flightGroup coalesces concurrent lookups of the same UUID so that only one
+// of them goes upstream and the others wait for, and share, its result.
+type flightGroup struct {
+	mu        sync.Mutex
+	flights   map[string]*flight
+	coalesced metrics.Counter
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{
+		flights:   make(map[string]*flight),
+		coalesced: metrics.GetOrRegisterCounter("people.upstream.coalesced", metrics.DefaultRegistry),
+	}
+}
+
+// do runs fn for uuid unless a lookup of the same UUID is already in flight, in which case
+// it waits for that lookup instead. leader is the transaction ID of the request whose
+// lookup was shared, or empty if fn was run on behalf of the caller.
+//
+// fn is not bound to the context of any one caller, so a caller going away does not fail
+// the lookup for the others. It is cancelled once every caller waiting for it has gone.
+// fn runs on a goroutine of its own, so a panic in it is recovered and returned to every
+// caller as errLookupPanicked rather than bringing the whole service down.
+func (g *flightGroup) do(ctx context.Context, uuid, tid string, fn func(ctx context.Context) (cachedPerson, error)) (value cachedPerson, leader string, err error) {
+	g.mu.Lock()
+	f, ok := g.flights[uuid]
+	if ok {
+		f.waiters++
+		leader = f.tid
+		g.coalesced.Inc(1)
+	} else {
+		flightCtx, cancel := context.WithCancel(context.Background())
+		f = &flight{tid: tid, done: make(chan struct{}), cancel: cancel, waiters: 1}
+		g.flights[uuid] = f
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					logger.WithTransactionID(f.tid).WithUUID(uuid).WithField("stack", string(debug.Stack())).Errorf("Lookup of person panicked: %v", r)
+					f.value, f.err = cachedPerson{}, fmt.Errorf("%w: %v", errLookupPanicked, r)
+				}
+				g.forget(uuid, f)
+				cancel()
+				close(f.done)
+			}()
+			f.value, f.err = fn(flightCtx)
+		}()
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.value, leader, f.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			f.cancel()
+			g.forgetLocked(uuid, f)
+		}
+		g.mu.Unlock()
+		return cachedPerson{}, leader, ctx.Err()
+	}
+}
+
+func (g *flightGroup) forget(uuid string, f *flight) {
+	g.mu.Lock()
+	g.forgetLocked(uuid, f)
+	g.mu.Unlock()
+}
+
+func (g *flightGroup) forgetLocked(uuid string, f *flight) {
+	if g.flights[uuid] == f {
+		delete(g.flights, uuid)
+	}
+}
@@ -0,0 +1,218 @@
+package people
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Financial-Times/go-logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlightGroup_CoalescesConcurrentLookups(t *testing.T) {
+	g := newFlightGroup()
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var calls int32
+
+	fn := func(ctx context.Context) (cachedPerson, error) {
+		atomic.AddInt32(&calls, 1)
+		close(started)
+		<-release
+		return cachedPerson{person: Person{Thing: Thing{PrefLabel: "Neil Cole"}}, found: true}, nil
+	}
+
+	var wg sync.WaitGroup
+	leaders := make([]string, 5)
+	values := make([]cachedPerson, 5)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		values[0], leaders[0], _ = g.do(context.Background(), "uuid", "tid_0", fn)
+	}()
+	<-started
+
+	for i := 1; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			values[i], leaders[i], _ = g.do(context.Background(), "uuid", fmt.Sprintf("tid_%d", i), fn)
+		}(i)
+	}
+	waitForWaiters(t, g, "uuid", 5)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls)
+	assert.Equal(t, "", leaders[0])
+	for i := 1; i < 5; i++ {
+		assert.Equal(t, "tid_0", leaders[i])
+		assert.Equal(t, "Neil Cole", values[i].person.PrefLabel)
+	}
+	assert.Empty(t, g.flights)
+}
+
+func TestFlightGroup_SharesErrors(t *testing.T) {
+	g := newFlightGroup()
+	release := make(chan struct{})
+	started := make(chan struct{})
+	expectedErr := errors.New("upstream failure")
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, _, errs[0] = g.do(context.Background(), "uuid", "tid_0", func(ctx context.Context) (cachedPerson, error) {
+			close(started)
+			<-release
+			return cachedPerson{}, expectedErr
+		})
+	}()
+	<-started
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, _, errs[1] = g.do(context.Background(), "uuid", "tid_1", func(ctx context.Context) (cachedPerson, error) {
+			t.Error("follower should not call upstream")
+			return cachedPerson{}, nil
+		})
+	}()
+	waitForWaiters(t, g, "uuid", 2)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, expectedErr, errs[0])
+	assert.Equal(t, expectedErr, errs[1])
+}
+
+func TestFlightGroup_SequentialLookupsAreNotShared(t *testing.T) {
+	g := newFlightGroup()
+	calls := 0
+	fn := func(ctx context.Context) (cachedPerson, error) {
+		calls++
+		return cachedPerson{found: true}, nil
+	}
+
+	_, leader, _ := g.do(context.Background(), "uuid", "tid_0", fn)
+	assert.Equal(t, "", leader)
+	_, leader, _ = g.do(context.Background(), "uuid", "tid_1", fn)
+	assert.Equal(t, "", leader)
+	assert.Equal(t, 2, calls)
+}
+
+func TestFlightGroup_CallerGoingAwayDoesNotFailOthers(t *testing.T) {
+	g := newFlightGroup()
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	var wg sync.WaitGroup
+	var leaderValue cachedPerson
+	var leaderErr error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		leaderValue, _, leaderErr = g.do(context.Background(), "uuid", "tid_0", func(ctx context.Context) (cachedPerson, error) {
+			close(started)
+			select {
+			case <-release:
+				return cachedPerson{found: true}, nil
+			case <-ctx.Done():
+				return cachedPerson{}, ctx.Err()
+			}
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, leader, err := g.do(ctx, "uuid", "tid_1", nil)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, "tid_0", leader)
+
+	close(release)
+	wg.Wait()
+	assert.NoError(t, leaderErr)
+	assert.True(t, leaderValue.found)
+}
+
+func TestFlightGroup_CancelledOnceEveryCallerHasGone(t *testing.T) {
+	g := newFlightGroup()
+	cancelled := make(chan struct{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-time.After(10 * time.Millisecond)
+		cancel()
+	}()
+	_, _, err := g.do(ctx, "uuid", "tid_0", func(ctx context.Context) (cachedPerson, error) {
+		<-ctx.Done()
+		close(cancelled)
+		return cachedPerson{}, ctx.Err()
+	})
+	assert.Equal(t, context.Canceled, err)
+
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the upstream lookup should have been cancelled")
+	}
+}
+
+func waitForWaiters(t *testing.T, g *flightGroup, uuid string, n int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		g.mu.Lock()
+		f, ok := g.flights[uuid]
+		waiters := 0
+		if ok {
+			waiters = f.waiters
+		}
+		g.mu.Unlock()
+		if waiters >= n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d callers to wait for the lookup of %s", n, uuid)
+}
+
+func TestFlightGroup_PanicFailsEveryCaller(t *testing.T) {
+	logger.InitDefaultLogger("coalesce-test")
+	g := newFlightGroup()
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, _, errs[0] = g.do(context.Background(), "uuid", "tid_0", func(ctx context.Context) (cachedPerson, error) {
+			close(started)
+			<-release
+			panic("interface conversion: interface {} is float64, not string")
+		})
+	}()
+	<-started
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, _, errs[1] = g.do(context.Background(), "uuid", "tid_1", nil)
+	}()
+	waitForWaiters(t, g, "uuid", 2)
+	close(release)
+	wg.Wait()
+
+	for _, err := range errs {
+		assert.True(t, errors.Is(err, errLookupPanicked), err)
+	}
+	assert.Empty(t, g.flights)
+}
@@ -9,6 +9,8 @@ import (
 const (
 	thingsApiUrl = "http://api.ft.com/things/"
 	ftThing      = "http://www.ft.com/thing/"
+	ftOrgType    = "http://www.ft.com/ontology/organisation/Organisation"
+	ftPersonType = "http://www.ft.com/ontology/person/Person"
 )
 
 func convertToPerson(concept Concept, p *Person) {
@@ -24,22 +26,40 @@ func convertToPerson(concept Concept, p *Person) {
 	p.DirectType = concept.Type
 	p.IsDeprecated = concept.IsDeprecated
 
+	var accounts []Account
 	for _, account := range concept.Account {
+		value, ok := coerceString(account.Value)
+		if !ok {
+			skipTypedValue(concept.ID, account)
+			continue
+		}
+		accounts = append(accounts, Account{Type: friendlyTypeName(account.Type), Value: value})
+
 		switch {
 		case strings.Contains(account.Type, "facebookProfile"):
-			p.FacebookProfile = account.Value.(string)
+			p.FacebookProfile = value
 		case strings.Contains(account.Type, "twitterHandle"):
-			p.TwitterHandle = account.Value.(string)
+			p.TwitterHandle = value
 		case strings.Contains(account.Type, "emailAddress"):
-			p.EmailAddress = account.Value.(string)
+			p.EmailAddress = value
 		}
 	}
+	p.Accounts = accounts
 
 	var labels []string
+	var alternativeLabels []AlternativeLabel
 	for _, label := range concept.AlternativeLabels {
-		labels = append(labels, label.Value.(string))
+		values, ok := coerceStrings(label.Value)
+		if !ok {
+			skipTypedValue(concept.ID, label)
+		}
+		labels = append(labels, values...)
+		for _, value := range values {
+			alternativeLabels = append(alternativeLabels, AlternativeLabel{Type: friendlyTypeName(label.Type), Value: value})
+		}
 	}
 	p.Labels = labels
+	p.AlternativeLabels = alternativeLabels
 
 	var memberships []Membership
 	for _, related := range concept.RelatedConcepts {
@@ -70,13 +90,56 @@ func convertToMembership(c Concept) *Membership {
 	m.DirectType = c.Type
 	m.Organisation = organisation
 	m.Roles = roles
-	if len(c.ChangeEvents) > 0 {
-		m.ChangeEvents = c.ChangeEvents
-	}
+	m.ChangeEvents = normaliseChangeEvents(c.ID, c.ChangeEvents)
 
 	return &m
 }
 
+// convertToOrganisationMembers converts the memberships related to an organisation, as returned
+// by the concepts API, to the people holding them. Memberships not related to a person are skipped.
+func convertToOrganisationMembers(org Concept) []OrganisationMember {
+	organisation := convertToOrganisation(org)
+
+	var members []OrganisationMember
+	for _, related := range org.RelatedConcepts {
+		if !strings.Contains(related.Concept.Type, "Membership") {
+			continue
+		}
+
+		var person *Thing
+		for _, r := range related.Concept.RelatedConcepts {
+			if strings.Contains(r.Concept.Type, "Person") {
+				person = &Thing{
+					ID:        convertID(r.Concept.ID),
+					APIURL:    convertApiUrl(r.Concept.APIURL, "people"),
+					PrefLabel: r.Concept.PrefLabel,
+				}
+				break
+			}
+		}
+		if person == nil {
+			continue
+		}
+
+		membership := convertToMembership(related.Concept)
+		if membership.Organisation.ID == "" {
+			membership.Organisation = *organisation
+		}
+		members = append(members, OrganisationMember{Person: *person, Membership: *membership})
+	}
+	return members
+}
+
+// isOrganisation reports whether conceptType is Organisation or one of its subtypes, such as PublicCompany
+func isOrganisation(conceptType string) bool {
+	for _, t := range mapper.FullTypeHierarchy(conceptType) {
+		if t == ftOrgType {
+			return true
+		}
+	}
+	return false
+}
+
 func convertToOrganisation(c Concept) *Organisation {
 	var o Organisation
 	o.ID = convertID(c.ID)
@@ -84,6 +147,19 @@ func convertToOrganisation(c Concept) *Organisation {
 	o.PrefLabel = c.PrefLabel
 	o.Types = mapper.FullTypeHierarchy(c.Type)
 	o.DirectType = c.Type
+	o.CountryCode = c.CountryCode
+	o.CountryOfIncorporation = c.CountryOfIncorporation
+	o.LeiCode = c.LeiCode
+	o.PostalCode = c.PostalCode
+	o.YearFounded = c.YearFounded
+
+	for _, label := range c.AlternativeLabels {
+		values, ok := coerceStrings(label.Value)
+		if !ok {
+			skipTypedValue(c.ID, label)
+		}
+		o.Labels = append(o.Labels, values...)
+	}
 	return &o
 }
 
@@ -95,13 +171,21 @@ func convertToRole(c Concept) *Role {
 	r.Types = mapper.FullTypeHierarchy(c.Type)
 	r.DirectType = c.Type
 
-	if len(c.ChangeEvents) > 0 {
-		r.ChangeEvents = c.ChangeEvents
-	}
+	r.ChangeEvents = normaliseChangeEvents(c.ID, c.ChangeEvents)
 
 	return &r
 }
 
+// friendlyTypeName shortens a type URI, such as http://www.ft.com/ontology/FormerName, to its
+// last segment starting with a lower case letter, such as formerName
+func friendlyTypeName(typeURI string) string {
+	name := typeURI[strings.LastIndexAny(typeURI, "/#")+1:]
+	if name == "" {
+		return ""
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
 func convertApiUrl(conceptsApiUrl string, desired string) string {
 	return strings.Replace(conceptsApiUrl, "concepts", desired, 1)
 }
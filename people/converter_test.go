@@ -0,0 +1,125 @@
+package people
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/Financial-Times/go-logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertToPerson_NonStringValues(t *testing.T) {
+	logger.InitDefaultLogger("converter-test")
+	before := skippedTypedValues.Count()
+
+	var concept Concept
+	err := json.Unmarshal([]byte(`{
+		"id": "http://www.ft.com/thing/60e54253-1e94-38df-83b1-a39804d1ac18",
+		"type": "http://www.ft.com/ontology/person/Person",
+		"account": [
+			{"type": "http://www.ft.com/ontology/emailAddress", "value": null},
+			{"type": "http://www.ft.com/ontology/twitterHandle", "value": 12345},
+			{"type": "http://www.ft.com/ontology/facebookProfile", "value": {"url": "https://www.facebook.com/financialtimes/"}},
+			{"type": "http://www.ft.com/ontology/unknownAccount", "value": ["ignored"]}
+		],
+		"alternativeLabels": [
+			{"type": "http://www.ft.com/ontology/Alias", "value": "Neil Cole"},
+			{"type": "http://www.ft.com/ontology/Alias", "value": ["Neil R. Cole", 7, null]},
+			{"type": "http://www.ft.com/ontology/Alias", "value": true},
+			{"type": "http://www.ft.com/ontology/Alias", "value": 1.5},
+			{"type": "http://www.ft.com/ontology/Alias", "value": null}
+		]
+	}`), &concept)
+	assert.NoError(t, err)
+
+	var p Person
+	assert.NotPanics(t, func() { convertToPerson(concept, &p) })
+
+	assert.Empty(t, p.EmailAddress)
+	assert.Equal(t, "12345", p.TwitterHandle)
+	assert.Empty(t, p.FacebookProfile)
+	assert.Equal(t, []Account{{Type: "twitterHandle", Value: "12345"}}, p.Accounts)
+	assert.Equal(t, []string{"Neil Cole", "Neil R. Cole", "7", "true", "1.5"}, p.Labels)
+	assert.Equal(t, int64(5), skippedTypedValues.Count()-before)
+}
+
+func TestConvertToPerson_Accounts(t *testing.T) {
+	logger.InitDefaultLogger("converter-test")
+	concept := Concept{
+		Account: []TypedValue{
+			{Type: "http://www.ft.com/ontology/emailAddress", Value: "example@example.com"},
+			{Type: "http://www.ft.com/ontology/linkedInProfile", Value: "https://www.linkedin.com/in/example"},
+			{Type: "http://www.ft.com/ontology/personalWebsite", Value: "https://example.com"},
+			{Type: "http://www.ft.com/ontology/wikidataId", Value: "Q12345"},
+			{Type: "http://www.ft.com/ontology/mastodonHandle", Value: nil},
+		},
+	}
+
+	var p Person
+	convertToPerson(concept, &p)
+
+	assert.Equal(t, "example@example.com", p.EmailAddress)
+	assert.Equal(t, []Account{
+		{Type: "emailAddress", Value: "example@example.com"},
+		{Type: "linkedInProfile", Value: "https://www.linkedin.com/in/example"},
+		{Type: "personalWebsite", Value: "https://example.com"},
+		{Type: "wikidataId", Value: "Q12345"},
+	}, p.Accounts)
+}
+
+func TestConvertToPerson_AlternativeLabels(t *testing.T) {
+	concept := Concept{
+		AlternativeLabels: []TypedValue{
+			{Type: "http://www.ft.com/ontology/Alias", Value: "Neil Cole"},
+			{Type: "http://www.ft.com/ontology/FormerName", Value: []interface{}{"Neil R. Cole", "N. Cole"}},
+			{Type: "http://www.ft.com/ontology/ProperName", Value: "Neil Richard Cole"},
+			{Type: "http://www.ft.com/ontology/ShortName", Value: "Cole"},
+			{Value: "Untyped"},
+		},
+	}
+
+	var p Person
+	convertToPerson(concept, &p)
+
+	assert.Equal(t, []string{"Neil Cole", "Neil R. Cole", "N. Cole", "Neil Richard Cole", "Cole", "Untyped"}, p.Labels)
+	assert.Equal(t, []AlternativeLabel{
+		{Type: "alias", Value: "Neil Cole"},
+		{Type: "formerName", Value: "Neil R. Cole"},
+		{Type: "formerName", Value: "N. Cole"},
+		{Type: "properName", Value: "Neil Richard Cole"},
+		{Type: "shortName", Value: "Cole"},
+		{Value: "Untyped"},
+	}, p.AlternativeLabels)
+}
+
+func FuzzConvertToPerson(f *testing.F) {
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	f.Add(fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, ""))
+	f.Add(fmt.Sprintf(conceptAPIManyMembershipsResponseTemplate, uuid))
+	f.Add(conceptAPIOrganisationResponse)
+	f.Add(`{"id": "http://www.ft.com/thing/` + uuid + `", "account": [{"type": "emailAddress", "value": [1, {"a": null}]}], "alternativeLabels": [{"value": null}]}`)
+	f.Add(`{"relatedConcepts": [{"concept": {"type": "Membership", "changeEvents": [{"startedAt": "1979-13-45"}, {"endedAt": ""}]}}]}`)
+
+	logger.InitDefaultLogger("converter-test")
+	f.Fuzz(func(t *testing.T, payload string) {
+		var concept Concept
+		if err := json.Unmarshal([]byte(payload), &concept); err != nil {
+			return
+		}
+
+		var p Person
+		convertToPerson(concept, &p)
+
+		if _, err := json.Marshal(p); err != nil {
+			t.Errorf("converted person cannot be encoded: %v", err)
+		}
+		for _, m := range p.Memberships {
+			for _, e := range m.ChangeEvents {
+				if (e.StartedAt != "" && e.StartedAtPrecision == "") || (e.EndedAt != "" && e.EndedAtPrecision == "") {
+					t.Errorf("change event %+v has a date without a precision", e)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package people
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Financial-Times/go-logger"
+	"github.com/rcrowley/go-metrics"
+)
+
+const (
+	precisionYear  = "year"
+	precisionMonth = "month"
+	precisionDay   = "day"
+
+	// eventDateLayout is the form every change event date is normalised to
+	eventDateLayout = "2006-01-02"
+)
+
+// dateLayouts are the formats change event dates are read in, most precise first
+var dateLayouts = []struct {
+	layout    string
+	precision string
+}{
+	{time.RFC3339Nano, precisionDay},
+	{"2006-01-02T15:04:05", precisionDay},
+	{"2006-01-02", precisionDay},
+	{"2006-01", precisionMonth},
+	{"2006", precisionYear},
+}
+
+var unparseableDates = metrics.GetOrRegisterCounter("people.changeevents.unparseable", metrics.DefaultRegistry)
+
+// normaliseDate reads a change event date given to the year, month or day, with or without
+// a time, and returns it as an ISO-8601 date along with the precision it was given to.
+// Dates given to the year or month are returned as the first day of the year or month.
+func normaliseDate(s string) (string, string, bool) {
+	s = strings.TrimSpace(s)
+	for _, l := range dateLayouts {
+		if t, err := time.Parse(l.layout, s); err == nil {
+			return t.Format(eventDateLayout), l.precision, true
+		}
+	}
+	return "", "", false
+}
+
+// normaliseChangeEvents returns events with their dates normalised, each along with its own
+// precision. Dates that cannot be read are logged, counted and dropped, as are events left
+// without any date.
+func normaliseChangeEvents(uuid string, events []ChangeEvent) []ChangeEvent {
+	var normalised []ChangeEvent
+	for _, e := range events {
+		var n ChangeEvent
+		n.StartedAt, n.StartedAtPrecision = normaliseEventDate(uuid, "startedAt", e.StartedAt)
+		n.EndedAt, n.EndedAtPrecision = normaliseEventDate(uuid, "endedAt", e.EndedAt)
+		if n.StartedAt != "" || n.EndedAt != "" {
+			normalised = append(normalised, n)
+		}
+	}
+	return normalised
+}
+
+// parseEventDate reads a change event date as normalised by normaliseChangeEvents
+func parseEventDate(s string) (time.Time, bool) {
+	t, err := time.Parse(eventDateLayout, s)
+	return t, err == nil
+}
+
+func normaliseEventDate(uuid, field, value string) (string, string) {
+	if value == "" {
+		return "", ""
+	}
+	date, precision, ok := normaliseDate(value)
+	if !ok {
+		logger.WithField("uuid", uuid).WithField(field, value).Warn("Dropping change event date that could not be parsed")
+		unparseableDates.Inc(1)
+		return "", ""
+	}
+	return date, precision
+}
@@ -0,0 +1,73 @@
+package people
+
+import (
+	"testing"
+
+	"github.com/Financial-Times/go-logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormaliseDate(t *testing.T) {
+	tests := []struct {
+		value     string
+		date      string
+		precision string
+	}{
+		{"1979-07-14", "1979-07-14", precisionDay},
+		{"1979-07", "1979-07-01", precisionMonth},
+		{"1979", "1979-01-01", precisionYear},
+		{" 1979 ", "1979-01-01", precisionYear},
+		{"2001-01-01T00:00:00Z", "2001-01-01", precisionDay},
+		{"2001-01-01T00:00:00.000Z", "2001-01-01", precisionDay},
+		{"2001-01-01T23:30:00-05:00", "2001-01-01", precisionDay},
+		{"2001-01-01T12:00:00", "2001-01-01", precisionDay},
+	}
+	for _, test := range tests {
+		date, precision, ok := normaliseDate(test.value)
+		assert.True(t, ok, test.value)
+		assert.Equal(t, test.date, date, test.value)
+		assert.Equal(t, test.precision, precision, test.value)
+	}
+
+	for _, value := range []string{"", "sometime", "14/07/1979", "1979-13", "79"} {
+		_, _, ok := normaliseDate(value)
+		assert.False(t, ok, value)
+	}
+}
+
+func TestParseEventDate(t *testing.T) {
+	for _, value := range []string{"1979", "1979-07", "1979-07-14"} {
+		date, _, ok := normaliseDate(value)
+		assert.True(t, ok)
+		_, ok = parseEventDate(date)
+		assert.True(t, ok, "normalised %s", value)
+	}
+
+	for _, value := range []string{"", "1979", "1979-07", "1979-07-14T00:00:00Z"} {
+		_, ok := parseEventDate(value)
+		assert.False(t, ok, "only normalised dates should be read, not %q", value)
+	}
+}
+
+func TestNormaliseChangeEvents(t *testing.T) {
+	logger.InitDefaultLogger("dates-test")
+	before := unparseableDates.Count()
+
+	events := normaliseChangeEvents("http://www.ft.com/thing/uuid", []ChangeEvent{
+		{StartedAt: "1979"},
+		{EndedAt: "1982-07-31T00:00:00Z"},
+		{StartedAt: "2001-05", EndedAt: "2003-02-01"},
+		{StartedAt: "2005-01-01", EndedAt: "whenever"},
+		{StartedAt: "sometime"},
+	})
+
+	assert.Equal(t, []ChangeEvent{
+		{StartedAt: "1979-01-01", StartedAtPrecision: precisionYear},
+		{EndedAt: "1982-07-31", EndedAtPrecision: precisionDay},
+		{StartedAt: "2001-05-01", StartedAtPrecision: precisionMonth, EndedAt: "2003-02-01", EndedAtPrecision: precisionDay},
+		{StartedAt: "2005-01-01", StartedAtPrecision: precisionDay},
+	}, events)
+	assert.Equal(t, int64(2), unparseableDates.Count()-before)
+
+	assert.Nil(t, normaliseChangeEvents("http://www.ft.com/thing/uuid", nil))
+}
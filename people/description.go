@@ -0,0 +1,223 @@
+package people
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"net/url"
+	"strings"
+)
+
+const (
+	descriptionFormatParam = "descriptionFormat"
+
+	descriptionXML  = "xml"
+	descriptionHTML = "html"
+	descriptionText = "text"
+
+	conceptsApiUrl = "http://api.ft.com/concepts/"
+)
+
+// parseDescriptionFormat reads the descriptionFormat query parameter, defaulting to xml
+func parseDescriptionFormat(query url.Values) (string, error) {
+	values, ok := query[descriptionFormatParam]
+	if !ok {
+		return descriptionXML, nil
+	}
+	if len(values) != 1 {
+		return "", fmt.Errorf("%s must be given once", descriptionFormatParam)
+	}
+	switch values[0] {
+	case descriptionXML, descriptionHTML, descriptionText:
+		return values[0], nil
+	}
+	return "", fmt.Errorf("unknown format %q, expected one of %s, %s or %s", values[0], descriptionHTML, descriptionText, descriptionXML)
+}
+
+// renderDescription returns a copy of p whose DescriptionXML is replaced by HTML or plain text
+// rendered from it. The description is left as XML if it cannot be read.
+func renderDescription(p Person, format string) (Person, error) {
+	if format == descriptionXML || p.DescriptionXML == "" {
+		return p, nil
+	}
+
+	render := descriptionToText
+	if format == descriptionHTML {
+		render = descriptionToHTML
+	}
+	rendered, err := render(p.DescriptionXML)
+	if err != nil {
+		return p, err
+	}
+
+	if format == descriptionHTML {
+		p.DescriptionHTML = rendered
+	} else {
+		p.DescriptionText = rendered
+	}
+	p.DescriptionXML = ""
+	return p, nil
+}
+
+// htmlElements are the elements of the FT body XML kept when rendering HTML.
+// The content of any other element is kept without the element itself.
+var htmlElements = map[string]bool{
+	"p": true, "br": true, "strong": true, "em": true, "b": true, "i": true, "u": true, "s": true,
+	"sub": true, "sup": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "li": true, "blockquote": true, "a": true,
+}
+
+// droppedElements are never rendered, including their content
+var droppedElements = map[string]bool{"script": true, "style": true, "iframe": true, "object": true, "embed": true}
+
+// blockElements start a new paragraph when rendering text
+var blockElements = map[string]bool{
+	"p": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "li": true, "blockquote": true,
+}
+
+// descriptionToHTML renders the FT body XML of a description as HTML holding only htmlElements.
+// Concept links, ft-concept and ft-content elements, become links to the public API.
+func descriptionToHTML(description string) (string, error) {
+	var out strings.Builder
+	err := walkDescription(description, func(t xml.Token) {
+		switch t := t.(type) {
+		case xml.StartElement:
+			name, href := htmlElement(t)
+			if name == "" {
+				return
+			}
+			out.WriteString("<" + name)
+			if href != "" {
+				out.WriteString(` href="` + html.EscapeString(href) + `"`)
+			}
+			out.WriteString(">")
+		case xml.EndElement:
+			if name, _ := htmlElement(xml.StartElement{Name: t.Name}); name != "" && name != "br" {
+				out.WriteString("</" + name + ">")
+			}
+		case xml.CharData:
+			out.WriteString(html.EscapeString(string(t)))
+		}
+	})
+	return strings.TrimSpace(out.String()), err
+}
+
+// htmlElement returns the HTML element an element of the body XML is rendered as, and the link it
+// holds, or an empty name if only its content is kept
+func htmlElement(e xml.StartElement) (string, string) {
+	switch e.Name.Local {
+	case "ft-concept", "ft-content":
+		return "a", safeHref(conceptURL(attr(e, "url", "id")))
+	case "a":
+		return "a", safeHref(conceptURL(attr(e, "href")))
+	}
+	if htmlElements[e.Name.Local] {
+		return e.Name.Local, ""
+	}
+	return "", ""
+}
+
+// descriptionToText renders the FT body XML of a description as plain text, separating
+// paragraphs with a blank line and collapsing any other whitespace
+func descriptionToText(description string) (string, error) {
+	var paragraphs []string
+	var current strings.Builder
+	endParagraph := func() {
+		if p := strings.Join(strings.Fields(current.String()), " "); p != "" {
+			paragraphs = append(paragraphs, p)
+		}
+		current.Reset()
+	}
+
+	err := walkDescription(description, func(t xml.Token) {
+		switch t := t.(type) {
+		case xml.StartElement:
+			if blockElements[t.Name.Local] {
+				endParagraph()
+			}
+			if t.Name.Local == "br" {
+				current.WriteString(" ")
+			}
+		case xml.EndElement:
+			if blockElements[t.Name.Local] {
+				endParagraph()
+			}
+		case xml.CharData:
+			current.Write(t)
+		}
+	})
+	endParagraph()
+	return strings.Join(paragraphs, "\n\n"), err
+}
+
+// walkDescription calls fn with each token of the description, skipping droppedElements.
+// The description is read leniently, as HTML entities and unclosed elements are common in it.
+func walkDescription(description string, fn func(xml.Token)) error {
+	d := xml.NewDecoder(strings.NewReader("<ft-description>" + description + "</ft-description>"))
+	d.Strict = false
+	d.AutoClose = xml.HTMLAutoClose
+	d.Entity = xml.HTMLEntity
+
+	dropped := 0
+	for {
+		t, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			if droppedElements[t.Name.Local] || dropped > 0 {
+				dropped++
+				continue
+			}
+		case xml.EndElement:
+			if dropped > 0 {
+				dropped--
+				continue
+			}
+		}
+		if dropped == 0 {
+			fn(xml.CopyToken(t))
+		}
+	}
+}
+
+func attr(e xml.StartElement, names ...string) string {
+	for _, name := range names {
+		for _, a := range e.Attr {
+			if a.Name.Local == name && a.Value != "" {
+				return a.Value
+			}
+		}
+	}
+	return ""
+}
+
+// conceptURL rewrites a link to a concept, by its ID or concepts API URL, to its public API URL
+func conceptURL(link string) string {
+	switch {
+	case strings.HasPrefix(link, ftThing):
+		return convertID(link)
+	case strings.HasPrefix(link, conceptsApiUrl):
+		return thingsApiUrl + strings.TrimPrefix(link, conceptsApiUrl)
+	}
+	return link
+}
+
+// safeHref drops links that could run script, keeping only web and mail links
+func safeHref(href string) string {
+	u, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "mailto", "":
+		return href
+	}
+	return ""
+}
@@ -0,0 +1,70 @@
+package people
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDescriptionXML = `<p>Neil Cole is the founder of <ft-concept url="http://api.ft.com/organisations/1d448227-8b1b-3490-aeb8-18aa699d75f8" type="http://www.ft.com/ontology/company/PublicCompany">Iconix &amp; Co</ft-concept>,
+  a brand <strong>management</strong> company.</p><script>alert("x")</script>
+<p>He was <em>previously</em> chief executive<br/>of <ft-concept id="http://www.ft.com/thing/8ec028a9-a5e7-49ae-8bd5-7cd0a57df1d6">Candie's</ft-concept>
+and a trustee of <a href="http://api.ft.com/concepts/2c1d3f4e-5a6b-3c7d-8e9f-0a1b2c3d4e5f" onclick="steal()">the trust</a>.</p>
+<ul><li>Founder</li><li>Investor <a href="javascript:alert(1)">here</a></li></ul><custom-widget data-x="1">Kept&nbsp;text</custom-widget>`
+
+func TestDescriptionToHTML(t *testing.T) {
+	rendered, err := descriptionToHTML(testDescriptionXML)
+	assert.NoError(t, err)
+	assert.Equal(t, `<p>Neil Cole is the founder of <a href="http://api.ft.com/organisations/1d448227-8b1b-3490-aeb8-18aa699d75f8">Iconix &amp; Co</a>,
+  a brand <strong>management</strong> company.</p>
+<p>He was <em>previously</em> chief executive<br>of <a href="http://api.ft.com/things/8ec028a9-a5e7-49ae-8bd5-7cd0a57df1d6">Candie&#39;s</a>
+and a trustee of <a href="http://api.ft.com/things/2c1d3f4e-5a6b-3c7d-8e9f-0a1b2c3d4e5f">the trust</a>.</p>
+<ul><li>Founder</li><li>Investor <a>here</a></li></ul>Kept`+"\u00a0"+`text`, rendered)
+}
+
+func TestDescriptionToText(t *testing.T) {
+	rendered, err := descriptionToText(testDescriptionXML)
+	assert.NoError(t, err)
+	assert.Equal(t, "Neil Cole is the founder of Iconix & Co, a brand management company.\n\n"+
+		"He was previously chief executive of Candie's and a trustee of the trust.\n\n"+
+		"Founder\n\nInvestor here\n\nKept text", rendered)
+}
+
+func TestRenderDescription(t *testing.T) {
+	person := Person{Description: "Founder", DescriptionXML: "<p>Founder of <b>Iconix</b></p>"}
+
+	rendered, err := renderDescription(person, descriptionXML)
+	assert.NoError(t, err)
+	assert.Equal(t, person, rendered)
+
+	rendered, err = renderDescription(person, descriptionHTML)
+	assert.NoError(t, err)
+	assert.Equal(t, Person{Description: "Founder", DescriptionHTML: "<p>Founder of <b>Iconix</b></p>"}, rendered)
+
+	rendered, err = renderDescription(person, descriptionText)
+	assert.NoError(t, err)
+	assert.Equal(t, Person{Description: "Founder", DescriptionText: "Founder of Iconix"}, rendered)
+
+	person.DescriptionXML = "<p>Unfinished <"
+	rendered, err = renderDescription(person, descriptionHTML)
+	assert.Error(t, err)
+	assert.Equal(t, person, rendered)
+}
+
+func TestParseDescriptionFormat(t *testing.T) {
+	format, err := parseDescriptionFormat(url.Values{})
+	assert.NoError(t, err)
+	assert.Equal(t, descriptionXML, format)
+
+	for _, f := range []string{descriptionHTML, descriptionText, descriptionXML} {
+		format, err = parseDescriptionFormat(url.Values{"descriptionFormat": {f}})
+		assert.NoError(t, err)
+		assert.Equal(t, f, format)
+	}
+
+	_, err = parseDescriptionFormat(url.Values{"descriptionFormat": {"markdown"}})
+	assert.Error(t, err)
+	_, err = parseDescriptionFormat(url.Values{"descriptionFormat": {"html", "text"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package people
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Codes identifying the kind of error in an ErrorResponse. Clients rely on these, so they must not change.
+const (
+	codeInvalidUUID         = "invalid_uuid"
+	codeInvalidParameter    = "invalid_parameter"
+	codeNoUUIDs             = "missing_uuid"
+	codeTooManyUUIDs        = "too_many_uuids"
+	codePersonNotFound      = "person_not_found"
+	codeOrgNotFound         = "organisation_not_found"
+	codeNotAcceptable       = "not_acceptable"
+	codePersonConcorded     = "person_concorded"
+	codeUpstreamBadRequest  = "upstream_bad_request"
+	codeUpstreamUnavailable = "upstream_unavailable"
+	codeUpstreamTimeout     = "upstream_timeout"
+	codeInternalError       = "internal_error"
+)
+
+// apiError is how an error is reported to clients
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+var (
+	errInvalidUUID          = apiError{http.StatusBadRequest, codeInvalidUUID, badRequestMsg}
+	errInvalidParameter     = apiError{http.StatusBadRequest, codeInvalidParameter, invalidParameterMsg}
+	errNoUUIDs              = apiError{http.StatusBadRequest, codeNoUUIDs, noUUIDsMsg}
+	errTooManyUUIDs         = apiError{http.StatusBadRequest, codeTooManyUUIDs, fmt.Sprintf(tooManyUUIDsMsg, maxBatchSize)}
+	errPersonNotFound       = apiError{http.StatusNotFound, codePersonNotFound, personNotFoundMsg}
+	errOrgNotFound          = apiError{http.StatusNotFound, codeOrgNotFound, organisationNotFoundMsg}
+	errNotAcceptable        = apiError{http.StatusNotAcceptable, codeNotAcceptable, fmt.Sprintf("Person can only be served as one of %s", strings.Join(personMediaTypes, ", "))}
+	errPersonUnavailable    = apiError{http.StatusServiceUnavailable, codeUpstreamUnavailable, conceptsAPIUnavailableMsg}
+	errPersonBadGateway     = apiError{http.StatusBadGateway, codeUpstreamBadRequest, conceptsAPIBadRequestMsg}
+	errPersonTimedOut       = apiError{http.StatusGatewayTimeout, codeUpstreamTimeout, personRetrievalTimedOut}
+	errPersonNotRetrievable = apiError{http.StatusInternalServerError, codeInternalError, personUnableToBeRetrieved}
+)
+
+func redirectTo(uuid, canonicalUUID string, status int) apiError {
+	return apiError{status, codePersonConcorded, fmt.Sprintf(redirectedPerson, uuid, canonicalUUID)}
+}
+
+// invalidParameter reports why a query parameter is invalid
+func invalidParameter(err error) apiError {
+	e := errInvalidParameter
+	e.message = fmt.Sprintf("%s: %v", invalidParameterMsg, err)
+	return e
+}
+
+// apiErrorFor maps an error retrieving a person to how it is reported to clients
+func apiErrorFor(err error) apiError {
+	switch {
+	case errors.Is(err, errUpstreamTimeout), errors.Is(err, context.DeadlineExceeded):
+		return errPersonTimedOut
+	case errors.Is(err, errUpstreamUnavailable), errors.Is(err, errCircuitOpen):
+		return errPersonUnavailable
+	case errors.Is(err, errUpstreamBadRequest):
+		return errPersonBadGateway
+	}
+	return errPersonNotRetrievable
+}
+
+func (e apiError) personResult() PersonResult {
+	return PersonResult{Status: e.status, Code: e.code, Message: e.message}
+}
+
+// Errors retrieving a concept from public-concepts-api. Errors returned while
+// retrieving a person wrap one of these and should be checked with errors.Is.
+var (
+	errNotFound            = errors.New("concept not found in public-concepts-api")
+	errUpstreamBadRequest  = errors.New("public-concepts-api rejected the request")
+	errUpstreamUnavailable = errors.New("public-concepts-api is unavailable")
+	errUpstreamTimeout     = errors.New("request to public-concepts-api timed out")
+	errMalformedConcept    = errors.New("public-concepts-api returned a malformed concept")
+)
+
+// upstreamError is returned when public-concepts-api answers with an unexpected status
+type upstreamError struct {
+	kind   error
+	status int
+}
+
+func (e *upstreamError) Error() string {
+	return fmt.Sprintf("%v: status %d", e.kind, e.status)
+}
+
+func (e *upstreamError) Unwrap() error {
+	return e.kind
+}
+
+// errorForStatus classifies a non-200 response from public-concepts-api
+func errorForStatus(status int) error {
+	switch {
+	case status == 404 || status == 410:
+		return errNotFound
+	case status >= 400 && status < 500:
+		return &upstreamError{kind: errUpstreamBadRequest, status: status}
+	}
+	return &upstreamError{kind: errUpstreamUnavailable, status: status}
+}
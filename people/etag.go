@@ -0,0 +1,49 @@
+package people
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/Financial-Times/go-logger"
+)
+
+// computeETag returns a strong entity tag for an encoded response body
+func computeETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// etagMatches reports whether an If-None-Match header matches etag.
+// As required for If-None-Match, tags are compared weakly, ignoring any W/ prefix.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// writeWithETag writes body with an ETag computed from it, or just a 304 Not Modified
+// if the client already holds a representation with the same tag
+func writeWithETag(w http.ResponseWriter, r *http.Request, tid string, body []byte) {
+	etag := computeETag(body)
+	w.Header().Set("ETag", etag)
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.Header().Del("Content-Type")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		logger.WithError(err).WithTransactionID(tid).Warn("Could not write response")
+	}
+}
@@ -0,0 +1,34 @@
+package people
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeETag(t *testing.T) {
+	etag := computeETag([]byte(`{"id":"a"}`))
+	assert.Equal(t, etag, computeETag([]byte(`{"id":"a"}`)))
+	assert.NotEqual(t, etag, computeETag([]byte(`{"id":"b"}`)))
+	assert.Equal(t, byte('"'), etag[0])
+	assert.Equal(t, byte('"'), etag[len(etag)-1])
+}
+
+func TestETagMatches(t *testing.T) {
+	etag := `"abc"`
+	tests := []struct {
+		ifNoneMatch string
+		expected    bool
+	}{
+		{``, false},
+		{`"abc"`, true},
+		{`W/"abc"`, true},
+		{`"xyz", "abc"`, true},
+		{`"xyz"`, false},
+		{`abc`, false},
+		{`*`, true},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, etagMatches(test.ifNoneMatch, etag), test.ifNoneMatch)
+	}
+}
@@ -0,0 +1,57 @@
+package people
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	expandParam        = "expand"
+	expandOrganisation = "organisation"
+)
+
+// expansions are the opt-in parts of a person, left out of the default representation to keep it small
+type expansions struct {
+	organisation bool
+}
+
+// parseExpand reads the comma separated, and possibly repeated, expand query parameter
+func parseExpand(query url.Values) (expansions, error) {
+	var e expansions
+	for _, value := range query[expandParam] {
+		for _, name := range strings.Split(value, ",") {
+			switch strings.TrimSpace(name) {
+			case expandOrganisation:
+				e.organisation = true
+			default:
+				return e, fmt.Errorf("unknown expansion %q, expected %s", name, expandOrganisation)
+			}
+		}
+	}
+	return e, nil
+}
+
+// apply returns a copy of p holding only the expansions asked for
+func (e expansions) apply(p Person) Person {
+	p.Memberships = e.memberships(p.Memberships)
+	return p
+}
+
+// memberships returns a copy of memberships holding only the expansions asked for.
+// Unless the organisation is expanded only its identity, types and label are kept.
+func (e expansions) memberships(memberships []Membership) []Membership {
+	if e.organisation || memberships == nil {
+		return memberships
+	}
+	summarised := make([]Membership, len(memberships))
+	for i, m := range memberships {
+		m.Organisation = Organisation{
+			Thing:      m.Organisation.Thing,
+			Types:      m.Organisation.Types,
+			DirectType: m.Organisation.DirectType,
+		}
+		summarised[i] = m
+	}
+	return summarised
+}
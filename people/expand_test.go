@@ -0,0 +1,48 @@
+package people
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExpand(t *testing.T) {
+	e, err := parseExpand(url.Values{})
+	assert.NoError(t, err)
+	assert.False(t, e.organisation)
+
+	e, err = parseExpand(url.Values{"expand": {"organisation"}})
+	assert.NoError(t, err)
+	assert.True(t, e.organisation)
+
+	e, err = parseExpand(url.Values{"expand": {" organisation ,organisation"}})
+	assert.NoError(t, err)
+	assert.True(t, e.organisation)
+
+	for _, value := range []string{"", "roles", "organisation,roles"} {
+		_, err = parseExpand(url.Values{"expand": {value}})
+		assert.Error(t, err, value)
+	}
+}
+
+func TestExpansions_Apply(t *testing.T) {
+	org := Organisation{
+		Thing:       Thing{ID: "http://api.ft.com/things/org", PrefLabel: "Iconix Brand Group Inc"},
+		Types:       []string{"http://www.ft.com/ontology/organisation/Organisation"},
+		DirectType:  "http://www.ft.com/ontology/organisation/Organisation",
+		Labels:      []string{"Iconix"},
+		CountryCode: "US",
+		LeiCode:     "5493003WW5CLS8P3BF72",
+		YearFounded: 1978,
+	}
+	person := Person{Memberships: []Membership{{Title: "Chairman", Organisation: org}}}
+
+	summarised := expansions{}.apply(person)
+	assert.Equal(t, Organisation{Thing: org.Thing, Types: org.Types, DirectType: org.DirectType}, summarised.Memberships[0].Organisation)
+	assert.Equal(t, "Chairman", summarised.Memberships[0].Title)
+	assert.Equal(t, org, person.Memberships[0].Organisation, "the original person must not be changed")
+
+	expanded := expansions{organisation: true}.apply(person)
+	assert.Equal(t, org, expanded.Memberships[0].Organisation)
+}
@@ -1,16 +1,20 @@
 package people
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"reflect"
 
 	"fmt"
-	"html"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	fthealth "github.com/Financial-Times/go-fthealth/v1_1"
@@ -25,23 +29,87 @@ const (
 	validUUID       = "([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})$"
 	contentTypeJson = "application/json; charset=UTF-8"
 
-	personNotFoundMsg         = "Person could not be retrieved"
+	personNotFoundMsg         = "Person not found"
+	organisationNotFoundMsg   = "Organisation not found"
 	personUnableToBeRetrieved = "Person could not be retrieved"
 	badRequestMsg             = "Invalid UUID"
 	redirectedPerson          = "Person %s is concorded to %s; serving redirect"
+	inlinedPerson             = "Person %s is concorded to %s; serving canonical person"
+	invalidParameterMsg       = "Invalid query parameter"
+	personRetrievalTimedOut   = "Timed out retrieving person"
+	conceptsAPIUnavailableMsg = "Person could not be retrieved as the concepts API is unavailable"
+	conceptsAPIBadRequestMsg  = "Person could not be retrieved as the concepts API rejected the request"
+	noUUIDsMsg                = "At least one uuid query parameter, or a q query parameter, is required"
+	tooManyUUIDsMsg           = "No more than %d uuids can be requested at once"
+	notAcceptableMsg          = "None of the media types accepted by %q can be served"
+
+	maxBatchSize     = 100
+	batchConcurrency = 10
 )
 
 type Handler struct {
 	cacheDuration        time.Duration
 	publicConceptsApiURL string
 	client               *http.Client
+	cache                *personCache
+	inflight             *flightGroup
+	retry                retryPolicy
+	breaker              *circuitBreaker
+	upstreamTimeout      time.Duration
+	redirectMode         RedirectMode
+	images               *imageService
+	searcher             Searcher
 }
 
-func NewHandler(cacheDuration time.Duration, publicConceptsApiURL string, c *http.Client) *Handler {
+// HandlerConfig holds the settings of the people handler
+type HandlerConfig struct {
+	// CacheDuration is advertised to clients in Cache-Control and is also how long
+	// people are kept in the in-process cache
+	CacheDuration        time.Duration
+	PublicConceptsApiURL string
+	// CacheSize is the maximum number of people held in the in-process cache, 0 disables it
+	CacheSize int
+	// UpstreamRetries is how many times a failed request to the concepts API is retried,
+	// waiting roughly UpstreamRetryBackoff before the first retry and twice as long before each next one
+	UpstreamRetries      int
+	UpstreamRetryBackoff time.Duration
+	// BreakerThreshold is the number of consecutive requests still failing after their retries that
+	// opens the circuit breaker, 0 disables it.
+	// The breaker lets a trial request through once it has been open for BreakerCooldown.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	// RedirectMode is how people requested by a concorded UUID are served, unless overridden per request
+	RedirectMode RedirectMode
+	// UpstreamTimeout bounds the time spent retrieving a person from the concepts API,
+	// retries included. 0 means no bound other than the inbound request's own deadline.
+	UpstreamTimeout time.Duration
+	// ImageURLTemplate is the URL of a rendition of an image in the image service, with {url}, {width},
+	// {height} and {format} placeholders. People get an image set of ImageRenditions when both are set.
+	ImageURLTemplate string
+	ImageRenditions  []ImageRendition
+	// Searcher finds people by name for GET /people?q=, searching the concepts API when nil
+	Searcher Searcher
+}
+
+func NewHandler(config HandlerConfig, c *http.Client) *Handler {
+	if config.RedirectMode == "" {
+		config.RedirectMode = RedirectMovedPermanently
+	}
 	h := &Handler{
-		cacheDuration:        cacheDuration,
-		publicConceptsApiURL: publicConceptsApiURL,
+		cacheDuration:        config.CacheDuration,
+		publicConceptsApiURL: config.PublicConceptsApiURL,
 		client:               c,
+		cache:                newPersonCache(config.CacheSize, config.CacheDuration),
+		inflight:             newFlightGroup(),
+		retry:                retryPolicy{retries: config.UpstreamRetries, backoff: config.UpstreamRetryBackoff},
+		breaker:              newCircuitBreaker(config.BreakerThreshold, config.BreakerCooldown),
+		upstreamTimeout:      config.UpstreamTimeout,
+		redirectMode:         config.RedirectMode,
+		images:               newImageService(config.ImageURLTemplate, config.ImageRenditions),
+		searcher:             config.Searcher,
+	}
+	if h.searcher == nil {
+		h.searcher = conceptsSearcher{h}
 	}
 	return h
 }
@@ -51,7 +119,20 @@ func (h *Handler) RegisterHandlers(router *mux.Router) {
 	handler := handlers.MethodHandler{
 		"GET": http.HandlerFunc(h.GetPerson),
 	}
+	router.Handle("/people/{uuid}.{format:"+vcardExtension+"}", handler)
 	router.Handle("/people/{uuid}", handler)
+	router.Handle("/people/{uuid}/memberships", handlers.MethodHandler{
+		"GET": http.HandlerFunc(h.GetMemberships),
+	})
+	router.Handle("/people/{uuid}/timeline", handlers.MethodHandler{
+		"GET": http.HandlerFunc(h.GetTimeline),
+	})
+	router.Handle("/organisations/{uuid}/people", handlers.MethodHandler{
+		"GET": http.HandlerFunc(h.GetOrganisationPeople),
+	})
+	router.Handle("/people", handlers.MethodHandler{
+		"GET": http.HandlerFunc(h.GetPeople),
+	})
 }
 
 // GetPerson is the public API
@@ -66,110 +147,589 @@ func (h *Handler) GetPerson(w http.ResponseWriter, r *http.Request) {
 
 	if uuid == "" || !validRegexp.MatchString(uuid) {
 		logger.WithTransactionID(transId).WithField("UUID", uuid).Error(badRequestMsg)
-		writeJSONStatus(w, badRequestMsg, http.StatusBadRequest)
+		writeJSONError(w, transId, errInvalidUUID, &ErrorDetails{UUID: uuid})
+		return
+	}
+
+	w.Header().Set("Vary", "Accept")
+	mediaType, ok := negotiate(r.Header.Get("Accept"), personMediaTypes)
+	if vars["format"] == vcardExtension {
+		mediaType, ok = mediaTypeVCard, true
+	}
+	if !ok {
+		logger.WithTransactionID(transId).WithUUID(uuid).Infof(notAcceptableMsg, r.Header.Get("Accept"))
+		writeJSONError(w, transId, errNotAcceptable, &ErrorDetails{UUID: uuid})
 		return
 	}
 
-	person, found, err := h.getPersonViaConceptsAPI(uuid, transId)
+	redirectMode, err := h.redirectModeFor(r)
 	if err != nil {
-		writeJSONStatus(w, personUnableToBeRetrieved, http.StatusInternalServerError)
+		writeInvalidParameter(w, transId, uuid, redirectParam, err)
 		return
 	}
-	if !found {
-		writeJSONStatus(w, personNotFoundMsg, http.StatusNotFound)
+
+	membershipFilter, param, err := parseMembershipFilter(r.URL.Query(), time.Now())
+	if err != nil {
+		writeInvalidParameter(w, transId, uuid, param, err)
+		return
+	}
+
+	expand, err := parseExpand(r.URL.Query())
+	if err != nil {
+		writeInvalidParameter(w, transId, uuid, expandParam, err)
 		return
 	}
 
+	descriptionFormat, err := parseDescriptionFormat(r.URL.Query())
+	if err != nil {
+		writeInvalidParameter(w, transId, uuid, descriptionFormatParam, err)
+		return
+	}
+
+	var fields fieldSet
+	if values, ok := r.URL.Query()[fieldsParam]; ok {
+		fields, err = parseFields(strings.Join(values, ","), reflect.TypeOf(Person{}))
+		if err == nil && mediaType != mediaTypeJSON {
+			err = fmt.Errorf("%s can only be used with %s", fieldsParam, mediaTypeJSON)
+		}
+		if err != nil {
+			writeInvalidParameter(w, transId, uuid, fieldsParam, err)
+			return
+		}
+	}
+
+	person, ok := h.resolvePerson(w, r, uuid, transId, redirectMode)
+	if !ok {
+		return
+	}
+
+	person = expand.apply(membershipFilter.apply(person))
+	if person, err = renderDescription(person, descriptionFormat); err != nil {
+		logger.WithError(err).WithTransactionID(transId).WithUUID(uuid).Warn("Could not render the description of the person, serving it as XML")
+	}
+
+	w.Header().Set("Content-Type", mediaType+"; charset=UTF-8")
+
+	var representation interface{} = person
+	switch {
+	case mediaType == mediaTypeVCard:
+		h.write(w, r, transId, toVCard(person, time.Now()))
+		return
+	case mediaType == mediaTypeTurtle:
+		h.write(w, r, transId, personGraph(person).turtle())
+		return
+	case mediaType == mediaTypeNTriples:
+		h.write(w, r, transId, personGraph(person).nTriples())
+		return
+	case mediaType == mediaTypeJSONLD:
+		representation = toJSONLD(person)
+	case fields != nil:
+		if representation, err = project(person, fields); err != nil {
+			logger.WithError(err).WithTransactionID(transId).WithUUID(uuid).Warn("Could not project person")
+			writeJSONError(w, transId, errPersonNotRetrievable, &ErrorDetails{UUID: uuid})
+			return
+		}
+	}
+
+	h.writeJSON(w, r, transId, uuid, representation)
+}
+
+// GetMemberships serves a page of the memberships of a person
+func (h *Handler) GetMemberships(w http.ResponseWriter, r *http.Request) {
+	uuid := mux.Vars(r)["uuid"]
+	transId := transactionidutils.GetTransactionIDFromRequest(r)
+	w.Header().Set("X-Request-Id", transId)
+	w.Header().Set("Content-Type", contentTypeJson)
+
+	validRegexp := regexp.MustCompile(validUUID)
+	if !validRegexp.MatchString(uuid) {
+		logger.WithTransactionID(transId).WithField("UUID", uuid).Error(badRequestMsg)
+		writeJSONError(w, transId, errInvalidUUID, &ErrorDetails{UUID: uuid})
+		return
+	}
+
+	redirectMode, err := h.redirectModeFor(r)
+	if err != nil {
+		writeInvalidParameter(w, transId, uuid, redirectParam, err)
+		return
+	}
+	membershipFilter, param, err := parseMembershipFilter(r.URL.Query(), time.Now())
+	if err != nil {
+		writeInvalidParameter(w, transId, uuid, param, err)
+		return
+	}
+	query, param, err := parseMembershipQuery(r.URL.Query(), validRegexp)
+	if err != nil {
+		writeInvalidParameter(w, transId, uuid, param, err)
+		return
+	}
+	pg, param, err := parsePage(r.URL.Query())
+	if err != nil {
+		writeInvalidParameter(w, transId, uuid, param, err)
+		return
+	}
+	expand, err := parseExpand(r.URL.Query())
+	if err != nil {
+		writeInvalidParameter(w, transId, uuid, expandParam, err)
+		return
+	}
+
+	person, ok := h.resolvePerson(w, r, uuid, transId, redirectMode)
+	if !ok {
+		return
+	}
+
+	memberships := query.apply(expand.apply(membershipFilter.apply(person)).Memberships)
+	start, end := pg.bounds(len(memberships))
+	h.writeJSON(w, r, transId, uuid, Memberships{
+		Memberships: append([]Membership{}, memberships[start:end]...),
+		Total:       len(memberships),
+		Links:       pg.links(r.URL, len(memberships)),
+	})
+}
+
+// GetTimeline serves the memberships and roles of a person as a chronological list of events
+func (h *Handler) GetTimeline(w http.ResponseWriter, r *http.Request) {
+	uuid := mux.Vars(r)["uuid"]
+	transId := transactionidutils.GetTransactionIDFromRequest(r)
+	w.Header().Set("X-Request-Id", transId)
+	w.Header().Set("Content-Type", contentTypeJson)
+
+	validRegexp := regexp.MustCompile(validUUID)
+	if !validRegexp.MatchString(uuid) {
+		logger.WithTransactionID(transId).WithField("UUID", uuid).Error(badRequestMsg)
+		writeJSONError(w, transId, errInvalidUUID, &ErrorDetails{UUID: uuid})
+		return
+	}
+
+	redirectMode, err := h.redirectModeFor(r)
+	if err != nil {
+		writeInvalidParameter(w, transId, uuid, redirectParam, err)
+		return
+	}
+
+	person, ok := h.resolvePerson(w, r, uuid, transId, redirectMode)
+	if !ok {
+		return
+	}
+
+	h.writeJSON(w, r, transId, uuid, buildTimeline(person))
+}
+
+// GetOrganisationPeople serves a page of the people holding memberships of an organisation
+func (h *Handler) GetOrganisationPeople(w http.ResponseWriter, r *http.Request) {
+	uuid := mux.Vars(r)["uuid"]
+	transId := transactionidutils.GetTransactionIDFromRequest(r)
+	w.Header().Set("X-Request-Id", transId)
+	w.Header().Set("Content-Type", contentTypeJson)
+
+	validRegexp := regexp.MustCompile(validUUID)
+	if !validRegexp.MatchString(uuid) {
+		logger.WithTransactionID(transId).WithField("UUID", uuid).Error(badRequestMsg)
+		writeJSONError(w, transId, errInvalidUUID, &ErrorDetails{UUID: uuid})
+		return
+	}
+
+	membershipFilter, param, err := parseMembershipFilter(r.URL.Query(), time.Now())
+	if err != nil {
+		writeInvalidParameter(w, transId, uuid, param, err)
+		return
+	}
+	pg, param, err := parsePage(r.URL.Query())
+	if err != nil {
+		writeInvalidParameter(w, transId, uuid, param, err)
+		return
+	}
+	expand, err := parseExpand(r.URL.Query())
+	if err != nil {
+		writeInvalidParameter(w, transId, uuid, expandParam, err)
+		return
+	}
+
+	org, err := h.getConcept(r.Context(), uuid, transId)
+	if err == context.Canceled {
+		logger.WithTransactionID(transId).WithUUID(uuid).Info("Request cancelled by the client")
+		return
+	}
+	if errors.Is(err, errNotFound) || (err == nil && !isOrganisation(org.Type)) {
+		writeJSONError(w, transId, errOrgNotFound, &ErrorDetails{UUID: uuid})
+		return
+	}
+	if err != nil {
+		writeJSONError(w, transId, apiErrorFor(err), &ErrorDetails{UUID: uuid})
+		return
+	}
+
+	var members []OrganisationMember
+	for _, member := range convertToOrganisationMembers(org) {
+		memberships := expand.memberships(membershipFilter.filter([]Membership{member.Membership}))
+		if len(memberships) == 0 {
+			continue
+		}
+		member.Membership = memberships[0]
+		members = append(members, member)
+	}
+	sortOrganisationMembers(members)
+
+	start, end := pg.bounds(len(members))
+	h.writeJSON(w, r, transId, uuid, OrganisationPeople{
+		People: append([]OrganisationMember{}, members[start:end]...),
+		Total:  len(members),
+		Links:  pg.links(r.URL, len(members)),
+	})
+}
+
+// resolvePerson retrieves the person requested by uuid, writing the response itself and
+// returning false when the person cannot be served, including when it is redirected
+func (h *Handler) resolvePerson(w http.ResponseWriter, r *http.Request, uuid, transId string, redirectMode RedirectMode) (Person, bool) {
+	person, found, err := h.getPersonViaConceptsAPI(r.Context(), uuid, transId)
+	if err == context.Canceled {
+		logger.WithTransactionID(transId).WithUUID(uuid).Info("Request cancelled by the client")
+		return person, false
+	}
+	if err != nil {
+		writeJSONError(w, transId, apiErrorFor(err), &ErrorDetails{UUID: uuid})
+		return person, false
+	}
+	if !found {
+		writeJSONError(w, transId, errPersonNotFound, &ErrorDetails{UUID: uuid})
+		return person, false
+	}
+
 	canonicalId := strings.TrimPrefix(person.ID, urlPrefix)
 	if canonicalId != uuid {
-		logger.WithTransactionID(transId).WithField("UUID", uuid).Infof(redirectedPerson, uuid, canonicalId)
 		redirectURL := strings.Replace(r.URL.String(), uuid, canonicalId, 1)
-		w.Header().Set("Location", redirectURL)
-		writeJSONStatus(w, fmt.Sprintf(redirectedPerson, uuid, canonicalId), http.StatusMovedPermanently)
+		if redirectMode != RedirectInline {
+			logger.WithTransactionID(transId).WithField("UUID", uuid).Infof(redirectedPerson, uuid, canonicalId)
+			w.Header().Set("Location", redirectURL)
+			writeJSONError(w, transId, redirectTo(uuid, canonicalId, redirectMode.status()), &ErrorDetails{UUID: uuid, CanonicalUUID: canonicalId})
+			return person, false
+		}
+		logger.WithTransactionID(transId).WithField("UUID", uuid).Infof(inlinedPerson, uuid, canonicalId)
+		w.Header().Set("Content-Location", redirectURL)
+		person.RequestedUUID = uuid
+	}
+	return person, true
+}
+
+// writeJSON writes a successful, cacheable, response about the person identified by uuid
+func (h *Handler) writeJSON(w http.ResponseWriter, r *http.Request, transId, uuid string, v interface{}) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(v); err != nil {
+		logger.WithError(err).WithTransactionID(transId).WithUUID(uuid).Warn("Could not encode person")
+		writeJSONError(w, transId, errPersonNotRetrievable, &ErrorDetails{UUID: uuid})
+		return
+	}
+
+	h.write(w, r, transId, body.Bytes())
+}
+
+// write writes a successful, cacheable, response of any content type
+func (h *Handler) write(w http.ResponseWriter, r *http.Request, transId string, body []byte) {
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%s, public", strconv.FormatFloat(h.cacheDuration.Seconds(), 'f', 0, 64)))
+	writeWithETag(w, r, transId, body)
+}
+
+// GetPeople looks up every person given as a uuid query parameter and returns
+// the individual results keyed by the requested UUID, or searches people by name
+// when given a q query parameter instead
+func (h *Handler) GetPeople(w http.ResponseWriter, r *http.Request) {
+	transId := transactionidutils.GetTransactionIDFromRequest(r)
+	w.Header().Set("X-Request-Id", transId)
+	w.Header().Set("Content-Type", contentTypeJson)
+
+	q, search, err := parseSearchQuery(r.URL.Query())
+	if err != nil {
+		writeInvalidParameter(w, transId, "", searchParam, err)
+		return
+	}
+	if search {
+		if len(r.URL.Query()["uuid"]) > 0 {
+			writeInvalidParameter(w, transId, "", searchParam, fmt.Errorf("%s cannot be combined with uuid", searchParam))
+			return
+		}
+		h.searchPeople(w, r, q, transId)
+		return
+	}
+
+	uuids := dedupe(r.URL.Query()["uuid"])
+	if len(uuids) == 0 {
+		writeJSONError(w, transId, errNoUUIDs, nil)
 		return
 	}
+	if len(uuids) > maxBatchSize {
+		writeJSONError(w, transId, errTooManyUUIDs, nil)
+		return
+	}
+
+	validRegexp := regexp.MustCompile(validUUID)
+	for _, uuid := range uuids {
+		if !validRegexp.MatchString(uuid) {
+			logger.WithTransactionID(transId).WithField("UUID", uuid).Error(badRequestMsg)
+			writeJSONError(w, transId, errInvalidUUID, &ErrorDetails{UUID: uuid})
+			return
+		}
+	}
+
+	expand, err := parseExpand(r.URL.Query())
+	if err != nil {
+		writeInvalidParameter(w, transId, "", expandParam, err)
+		return
+	}
+	descriptionFormat, err := parseDescriptionFormat(r.URL.Query())
+	if err != nil {
+		writeInvalidParameter(w, transId, "", descriptionFormatParam, err)
+		return
+	}
+
+	results := h.getPeopleViaConceptsAPI(r.Context(), uuids, transId)
+	for uuid, result := range results {
+		if result.Person != nil {
+			person, err := renderDescription(expand.apply(*result.Person), descriptionFormat)
+			if err != nil {
+				logger.WithError(err).WithTransactionID(transId).WithUUID(uuid).Warn("Could not render the description of the person, serving it as XML")
+			}
+			result.Person = &person
+			results[uuid] = result
+		}
+	}
 
 	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%s, public", strconv.FormatFloat(h.cacheDuration.Seconds(), 'f', 0, 64)))
 	w.WriteHeader(http.StatusOK)
 
-	if err = json.NewEncoder(w).Encode(person); err != nil {
-		writeJSONStatus(w, "Person could not be retrieved", http.StatusInternalServerError)
+	if err := json.NewEncoder(w).Encode(People{People: results}); err != nil {
+		logger.WithError(err).WithTransactionID(transId).Warn("Could not encode people")
+	}
+}
+
+// searchPeople serves a page of the people matching q, best matches first
+func (h *Handler) searchPeople(w http.ResponseWriter, r *http.Request, q, transId string) {
+	pg, param, err := parsePage(r.URL.Query())
+	if err != nil {
+		writeInvalidParameter(w, transId, "", param, err)
+		return
+	}
+
+	people, err := h.searcher.SearchPeople(r.Context(), q, transId)
+	if err == context.Canceled {
+		logger.WithTransactionID(transId).Info("Request cancelled by the client")
+		return
+	}
+	if err != nil {
+		logger.WithError(err).WithTransactionID(transId).Warn("Could not search people")
+		writeJSONError(w, transId, apiErrorFor(err), nil)
+		return
+	}
+
+	matches := rankPeople(people, q)
+	start, end := pg.bounds(len(matches))
+	h.writeJSON(w, r, transId, "", SearchResults{
+		People: append([]Thing{}, matches[start:end]...),
+		Total:  len(matches),
+		Links:  pg.links(r.URL, len(matches)),
+	})
+}
+
+// getPeopleViaConceptsAPI fetches the given people, at most batchConcurrency at a time.
+// A failure for one person is reported in its result and does not affect the others.
+func (h *Handler) getPeopleViaConceptsAPI(ctx context.Context, uuids []string, tid string) map[string]PersonResult {
+	results := make(map[string]PersonResult, len(uuids))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, batchConcurrency)
+
+	for _, uuid := range uuids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(uuid string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			result := h.getPersonResult(ctx, uuid, tid)
+			mu.Lock()
+			results[uuid] = result
+			mu.Unlock()
+		}(uuid)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (h *Handler) getPersonResult(ctx context.Context, uuid, tid string) PersonResult {
+	person, found, err := h.getPersonViaConceptsAPI(ctx, uuid, tid)
+	if err != nil {
+		return apiErrorFor(err).personResult()
+	}
+	if !found {
+		return errPersonNotFound.personResult()
+	}
+
+	canonicalId := strings.TrimPrefix(person.ID, urlPrefix)
+	if canonicalId != uuid {
+		result := redirectTo(uuid, canonicalId, http.StatusMovedPermanently).personResult()
+		result.Location = "/people/" + canonicalId
+		result.Person = &person
+		return result
+	}
+	return PersonResult{Status: http.StatusOK, Person: &person}
+}
+
+func dedupe(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var unique []string
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
+
+func (h *Handler) getPersonViaConceptsAPI(ctx context.Context, uuid, tid string) (person Person, found bool, err error) {
+	if cached, ok := h.cache.get(uuid); ok {
+		return cached.person, cached.found, nil
 	}
+
+	result, leader, err := h.inflight.do(ctx, uuid, tid, func(ctx context.Context) (cachedPerson, error) {
+		return h.fetchPerson(ctx, uuid, tid)
+	})
+	if leader != "" {
+		logger.WithTransactionID(tid).WithUUID(uuid).Infof("Shared the concepts API request of transaction %s", leader)
+	}
+	if err != nil {
+		return Person{}, false, err
+	}
+	return result.person, result.found, nil
 }
 
-func (h *Handler) getPersonViaConceptsAPI(uuid, tid string) (person Person, found bool, err error) {
+func (h *Handler) fetchPerson(ctx context.Context, uuid, tid string) (cachedPerson, error) {
 	var p Person
 
-	concept, err := h.getConcept(uuid, tid)
+	concept, err := h.getConcept(ctx, uuid, tid)
 	if err != nil {
-		if err.Error() == "Not found" {
-			return p, false, nil
+		if errors.Is(err, errNotFound) {
+			h.cache.set(uuid, cachedPerson{found: false})
+			return cachedPerson{found: false}, nil
 		}
-		return p, false, err
+		return cachedPerson{}, err
 	}
 
 	if strings.Contains(concept.Type, "Person") == false {
 		logger.WithTransactionID(tid).Infof("Concept Type is not person. type %s, uuid: %s", concept.Type, uuid)
-		return p, false, nil
+		h.cache.set(uuid, cachedPerson{found: false})
+		return cachedPerson{found: false}, nil
 	}
 
 	convertToPerson(concept, &p)
+	p.ImageSet = h.images.imageSet(p.ImageURL)
+	result := cachedPerson{person: p, found: true}
+	h.cache.set(uuid, result)
 
-	return p, true, nil
+	return result, nil
 }
 
-func (h *Handler) getConcept(uuid, tid string) (concept Concept, err error) {
+func (h *Handler) getConcept(ctx context.Context, uuid, tid string) (concept Concept, err error) {
 	var c Concept
 
+	query := url.Values{}
+	for _, relationship := range []string{"related"} {
+		query.Add("showRelationship", relationship)
+	}
+	if err := h.getFromConceptsAPI(ctx, "/concepts/"+uuid, query, uuid, tid, &c); err != nil {
+		return c, err
+	}
+
+	if c.ID == "" || c.Type == "" {
+		err := fmt.Errorf("%w: missing id or type", errMalformedConcept)
+		logger.WithError(err).WithTransactionID(tid).WithUUID(uuid).Warn("Error parsing json")
+		return c, err
+	}
+	return c, nil
+}
+
+// getFromConceptsAPI decodes the JSON response of the concepts API at path into v, bounding the
+// request by the upstream timeout and classifying its errors. uuid is only used for logging.
+func (h *Handler) getFromConceptsAPI(ctx context.Context, path string, query url.Values, uuid, tid string, v interface{}) error {
 	u, err := url.Parse(h.publicConceptsApiURL)
 	if err != nil {
 		msg := fmt.Sprintf("URL of Concepts API is invalid of %s", uuid)
 		logger.WithError(err).WithUUID(uuid).WithTransactionID(tid).Error(msg)
-		return c, err
+		return err
 	}
 
-	u.Path = "/concepts/" + uuid
-	q := u.Query()
-	for _, query := range []string{"related"} {
-		q.Add("showRelationship", query)
+	u.Path = path
+	u.RawQuery = query.Encode()
+
+	if h.upstreamTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.upstreamTimeout)
+		defer cancel()
 	}
-	u.RawQuery = q.Encode()
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return c, err
+		return err
 	}
+	req = req.WithContext(ctx)
 	req.Header.Set("X-Request-Id", tid)
 
-	resp, err := h.client.Do(req)
+	resp, err := h.do(req, tid)
 	if err != nil {
+		switch {
+		case ctx.Err() == context.DeadlineExceeded:
+			err = errUpstreamTimeout
+		case ctx.Err() != nil:
+			err = ctx.Err()
+		case err != errCircuitOpen:
+			err = fmt.Errorf("%w: %v", errUpstreamUnavailable, err)
+		}
 		logger.WithError(err).WithTransactionID(tid).Warnf("API request failed")
-		return c, err
+		return err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return c, fmt.Errorf("Not found")
+	if resp.StatusCode != http.StatusOK {
+		err := errorForStatus(resp.StatusCode)
+		if err != errNotFound {
+			logger.WithError(err).WithTransactionID(tid).WithUUID(uuid).Warn("API request failed")
+		}
+		return err
 	}
 
-	bytes, err := ioutil.ReadAll(resp.Body)
+	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			err = errUpstreamTimeout
+		}
 		logger.WithError(err).WithTransactionID(tid).Warnf("Error reading response body")
-		return c, err
+		return err
 	}
 
-	if err := json.Unmarshal(bytes, &c); err != nil {
+	if err := json.Unmarshal(body, v); err != nil {
 		logger.WithError(err).WithTransactionID(tid).Warnf("Error parsing json")
-		return c, err
+		return fmt.Errorf("%w: %v", errMalformedConcept, err)
 	}
-	return c, nil
+	return nil
 }
 
-func writeJSONStatus(rw http.ResponseWriter, message string, statusCode int) {
+func writeInvalidParameter(w http.ResponseWriter, tid, uuid, param string, err error) {
+	logger.WithError(err).WithTransactionID(tid).WithUUID(uuid).Info(invalidParameterMsg)
+	writeJSONError(w, tid, invalidParameter(err), &ErrorDetails{UUID: uuid, Parameter: param})
+}
+
+func writeJSONError(rw http.ResponseWriter, tid string, e apiError, details *ErrorDetails) {
 	rw.Header().Set("Content-Type", contentTypeJson)
-	rw.WriteHeader(statusCode)
-	logMsg := fmt.Sprintf(`{"message":"%s"}`, html.EscapeString(message))
-	if _, err := rw.Write([]byte(logMsg)); err != nil {
-		logger.WithError(err).Warnf("could not read json error")
+	rw.WriteHeader(e.status)
+	body := ErrorResponse{
+		Code:          e.code,
+		Message:       e.message,
+		TransactionID: tid,
+		Details:       details,
+	}
+	if err := json.NewEncoder(rw).Encode(body); err != nil {
+		logger.WithError(err).WithTransactionID(tid).Warnf("could not write json error")
 	}
 }
 
@@ -185,6 +745,18 @@ func (h *Handler) Healthchecks() fthealth.Check {
 	}
 }
 
+func (h *Handler) CircuitBreakerCheck() fthealth.Check {
+	return fthealth.Check{
+		ID:               "public-concepts-api-circuit-breaker-check",
+		BusinessImpact:   "Unable to respond to Public People API requests",
+		Name:             "Check the circuit breaker for public-concepts-api is closed",
+		PanicGuide:       "https://dewey.in.ft.com/runbooks/public-people-api",
+		Severity:         2,
+		TechnicalSummary: "The circuit breaker opens after repeated failures calling public-concepts-api. While it is open requests for people fail fast without calling public-concepts-api, until a trial request is let through once the cooldown is over.",
+		Checker:          h.breaker.Checker,
+	}
+}
+
 func (h *Handler) Checker() (string, error) {
 	req, err := http.NewRequest("GET", h.publicConceptsApiURL+"/__gtg", nil)
 	if err != nil {
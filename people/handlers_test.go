@@ -2,12 +2,16 @@ package people
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Financial-Times/go-logger"
 	"github.com/gorilla/mux"
@@ -24,7 +28,7 @@ type HandlerTestSuite struct {
 func (suite *HandlerTestSuite) SetupTest() {
 	logger.InitDefaultLogger("handler-test")
 	suite.router = mux.NewRouter()
-	suite.handler = NewHandler(0, "http://localhost:8080", http.DefaultClient)
+	suite.handler = NewHandler(HandlerConfig{PublicConceptsApiURL: "http://localhost:8080"}, http.DefaultClient)
 	suite.handler.RegisterHandlers(suite.router)
 }
 
@@ -123,6 +127,14 @@ func getExpectedPerson(uuid string, isDeprecated bool) Person {
 			"http://www.ft.com/ontology/person/Person",
 		},
 		Labels: []string{"Neil Cole"},
+		AlternativeLabels: []AlternativeLabel{
+			{Type: "alias", Value: "Neil Cole"},
+		},
+		Accounts: []Account{
+			{Type: "emailAddress", Value: "example@example.com"},
+			{Type: "twitterHandle", Value: "@ft"},
+			{Type: "facebookProfile", Value: "https://www.facebook.com/financialtimes/"},
+		},
 		Memberships: []Membership{
 			Membership{
 				Title: "Graduate Degree",
@@ -148,10 +160,12 @@ func getExpectedPerson(uuid string, isDeprecated bool) Person {
 				},
 				ChangeEvents: []ChangeEvent{
 					ChangeEvent{
-						StartedAt: "1979-01-01",
+						StartedAt:          "1979-01-01",
+						StartedAtPrecision: "day",
 					},
 					ChangeEvent{
-						EndedAt: "1982-01-01",
+						EndedAt:          "1982-01-01",
+						EndedAtPrecision: "day",
 					},
 				},
 				Roles: []Role{
@@ -169,10 +183,12 @@ func getExpectedPerson(uuid string, isDeprecated bool) Person {
 						DirectType: "http://www.ft.com/ontology/MembershipRole",
 						ChangeEvents: []ChangeEvent{
 							ChangeEvent{
-								StartedAt: "1979-01-01",
+								StartedAt:          "1979-01-01",
+								StartedAtPrecision: "day",
 							},
 							ChangeEvent{
-								EndedAt: "1982-01-01",
+								EndedAt:          "1982-01-01",
+								EndedAtPrecision: "day",
 							},
 						},
 					},
@@ -190,6 +206,38 @@ func getExpectedPerson(uuid string, isDeprecated bool) Person {
 	}
 }
 
+func (suite *HandlerTestSuite) TestGetPeople_ConditionalGet() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	url := "http://localhost:8080/concepts/" + uuid
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, "")))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid, ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+	etag := rec.Result().Header.Get("ETag")
+	suite.Equal(computeETag(rec.Body.Bytes()), etag)
+
+	req := newRequest("GET", "/people/"+uuid, "")
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Equal(http.StatusNotModified, rec.Result().StatusCode)
+	suite.Equal(etag, rec.Result().Header.Get("ETag"))
+	suite.Equal("max-age=0, public", rec.Result().Header.Get("Cache-Control"))
+	suite.Empty(rec.Body.Bytes())
+
+	req = newRequest("GET", "/people/"+uuid, "")
+	req.Header.Set("If-None-Match", `"stale"`)
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+	suite.Equal(etag, rec.Result().Header.Get("ETag"))
+	suite.NotEmpty(rec.Body.Bytes())
+}
+
 func (suite *HandlerTestSuite) TestGetPeople_NotFound() {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -286,12 +334,523 @@ func (suite *HandlerTestSuite) TestGetPeople_Redirect() {
 	suite.Equal(http.StatusMovedPermanently, rec.Result().StatusCode)
 }
 
+func (suite *HandlerTestSuite) TestGetPeople_RedirectModes() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "70f4732b-7f7d-30a1-9c29-0cceec23760e"
+	canonicalUUID := "2d3e16e0-61cb-4322-8aff-3b01c59f4daa"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, `{
+		"id": "http://www.ft.com/thing/2d3e16e0-61cb-4322-8aff-3b01c59f4daa",
+		"apiUrl": "http://api.ft.com/people/2d3e16e0-61cb-4322-8aff-3b01c59f4daa",
+		"prefLabel": "Someone",
+		"type": "http://www.ft.com/ontology/person/Person"
+	}`))
+
+	inlineHandler := NewHandler(HandlerConfig{PublicConceptsApiURL: "http://localhost:8080", RedirectMode: RedirectInline}, http.DefaultClient)
+	inlineRouter := mux.NewRouter()
+	inlineHandler.RegisterHandlers(inlineRouter)
+
+	tests := []struct {
+		name             string
+		router           *mux.Router
+		query            string
+		expectedStatus   int
+		expectedLocation string
+	}{
+		{"Default", suite.router, "", http.StatusMovedPermanently, "/people/" + canonicalUUID},
+		{"PermanentRedirectParam", suite.router, "?redirect=308", http.StatusPermanentRedirect, "/people/" + canonicalUUID + "?redirect=308"},
+		{"InlineParam", suite.router, "?redirect=inline", http.StatusOK, ""},
+		{"InlineConfig", inlineRouter, "", http.StatusOK, ""},
+		{"InlineConfigOverridden", inlineRouter, "?redirect=301", http.StatusMovedPermanently, "/people/" + canonicalUUID + "?redirect=301"},
+	}
+
+	for _, test := range tests {
+		rec := httptest.NewRecorder()
+		test.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+test.query, ""))
+		suite.Equal(test.expectedStatus, rec.Result().StatusCode, test.name)
+		suite.Equal(test.expectedLocation, rec.Result().Header.Get("Location"), test.name)
+
+		if test.expectedStatus == http.StatusOK {
+			suite.Equal("/people/"+canonicalUUID+test.query, rec.Result().Header.Get("Content-Location"), test.name)
+			retPerson := Person{}
+			json.NewDecoder(rec.Result().Body).Decode(&retPerson)
+			suite.Equal("http://api.ft.com/things/"+canonicalUUID, retPerson.ID, test.name)
+			suite.Equal(uuid, retPerson.RequestedUUID, test.name)
+		}
+	}
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_InvalidRedirectMode() {
+	uuid := "70f4732b-7f7d-30a1-9c29-0cceec23760e"
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"?redirect=302", ""))
+
+	ret := ErrorResponse{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(http.StatusBadRequest, rec.Result().StatusCode)
+	suite.Equal(codeInvalidParameter, ret.Code)
+	suite.Equal(&ErrorDetails{UUID: uuid, Parameter: redirectParam}, ret.Details)
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_Fields() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, "")))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"?fields=prefLabel,types,_imageUrl&fields=memberships.organisation.prefLabel", ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+	suite.JSONEq(`{
+		"prefLabel": "Neil Cole",
+		"types": [
+			"http://www.ft.com/ontology/core/Thing",
+			"http://www.ft.com/ontology/concept/Concept",
+			"http://www.ft.com/ontology/person/Person"
+		],
+		"_imageUrl": "https://www.ft.com/__origami/service/image/v2/images/raw/fthead-v1:merryn-somerset-webb?source=next",
+		"memberships": [
+			{"organisation": {"prefLabel": "Maurice A. Deane School of Law at Hofstra University"}}
+		]
+	}`, rec.Body.String())
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_UnknownField() {
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"?fields=prefLabel,memberships.salary", ""))
+
+	ret := ErrorResponse{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(http.StatusBadRequest, rec.Result().StatusCode)
+	suite.Equal(codeInvalidParameter, ret.Code)
+	suite.Equal(invalidParameterMsg+": unknown field memberships.salary", ret.Message)
+	suite.Equal(&ErrorDetails{UUID: uuid, Parameter: fieldsParam}, ret.Details)
+	suite.Equal(0, httpmock.GetTotalCallCount())
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_MembershipFilter() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, "")))
+
+	tests := []struct {
+		query               string
+		expectedMemberships int
+	}{
+		{"", 1},
+		{"?memberships=all", 1},
+		{"?memberships=current", 0},
+		{"?memberships=past", 1},
+		{"?memberships=current&asOf=1980-06-01", 1},
+		{"?memberships=past&asOf=1980-06-01", 0},
+	}
+	for _, test := range tests {
+		rec := httptest.NewRecorder()
+		suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+test.query, ""))
+		suite.Equal(http.StatusOK, rec.Result().StatusCode, test.query)
+
+		retPerson := Person{}
+		json.NewDecoder(rec.Result().Body).Decode(&retPerson)
+		suite.Len(retPerson.Memberships, test.expectedMemberships, test.query)
+	}
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"?memberships=current&asOf=yesterday", ""))
+	ret := ErrorResponse{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(http.StatusBadRequest, rec.Result().StatusCode)
+	suite.Equal(&ErrorDetails{UUID: uuid, Parameter: asOfParam}, ret.Details)
+}
+
+func (suite *HandlerTestSuite) TestGetPerson_ExpandOrganisation() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, "")))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"?expand=organisation", ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+
+	ret := Person{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal("US", ret.Memberships[0].Organisation.CountryOfIncorporation)
+	suite.Equal("Maurice A. Deane School of Law at Hofstra University", ret.Memberships[0].Organisation.PrefLabel)
+
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid, ""))
+	ret = Person{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Empty(ret.Memberships[0].Organisation.CountryOfIncorporation)
+
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people?uuid="+uuid+"&expand=organisation", ""))
+	people := People{}
+	json.NewDecoder(rec.Result().Body).Decode(&people)
+	suite.Equal("US", people.People[uuid].Person.Memberships[0].Organisation.CountryOfIncorporation)
+}
+
+func (suite *HandlerTestSuite) TestGetPerson_ImageSet() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	handler := NewHandler(HandlerConfig{
+		PublicConceptsApiURL: "http://localhost:8080",
+		ImageURLTemplate:     "https://images.example.com/{url}?width={width}&height={height}&format={format}",
+		ImageRenditions:      []ImageRendition{{Width: 150, Height: 150, Format: "jpg"}},
+	}, http.DefaultClient)
+	router := mux.NewRouter()
+	handler.RegisterHandlers(router)
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, "")))
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid, ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+
+	ret := Person{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal("https://www.ft.com/__origami/service/image/v2/images/raw/fthead-v1:merryn-somerset-webb?source=next", ret.ImageURL)
+	suite.Equal(&ImageSet{Renditions: []ImageRendition{{
+		Width:  150,
+		Height: 150,
+		Format: "jpg",
+		URL:    "https://images.example.com/" + url.QueryEscape(ret.ImageURL) + "?width=150&height=150&format=jpg",
+	}}}, ret.ImageSet)
+}
+
+func (suite *HandlerTestSuite) TestGetPerson_JSONLD() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, "")))
+
+	req := newRequest("GET", "/people/"+uuid, "")
+	req.Header.Set("Accept", "application/ld+json")
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+	suite.Equal("application/ld+json; charset=UTF-8", rec.Result().Header.Get("Content-Type"))
+	suite.Equal("Accept", rec.Result().Header.Get("Vary"))
+
+	ret := map[string]interface{}{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal("https://schema.org", ret["@context"])
+	suite.Equal("Person", ret["@type"])
+	suite.Equal("Neil Cole", ret["name"])
+	suite.Equal("OrganizationRole", ret["worksFor"].([]interface{})[0].(map[string]interface{})["@type"])
+
+	req = newRequest("GET", "/people/"+uuid, "")
+	req.Header.Set("Accept", "application/json")
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+	suite.Equal(contentTypeJson, rec.Result().Header.Get("Content-Type"))
+
+	req = newRequest("GET", "/people/"+uuid+"?fields=prefLabel", "")
+	req.Header.Set("Accept", "application/ld+json")
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Equal(http.StatusBadRequest, rec.Result().StatusCode)
+}
+
+func (suite *HandlerTestSuite) TestGetPerson_VCard() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, "")))
+
+	req := newRequest("GET", "/people/"+uuid, "")
+	req.Header.Set("Accept", "text/vcard")
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+	suite.Equal("text/vcard; charset=UTF-8", rec.Result().Header.Get("Content-Type"))
+	suite.NotEmpty(rec.Result().Header.Get("ETag"))
+
+	body, _ := io.ReadAll(rec.Result().Body)
+	suite.True(strings.HasPrefix(string(body), "BEGIN:VCARD\r\nVERSION:4.0\r\n"))
+	suite.Contains(string(body), "FN:Neil Cole\r\n")
+	suite.Contains(string(body), "EMAIL:example@example.com\r\n")
+
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+".vcf", ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+	suite.Equal("text/vcard; charset=UTF-8", rec.Result().Header.Get("Content-Type"))
+	vcf, _ := io.ReadAll(rec.Result().Body)
+	suite.Equal(body, vcf)
+}
+
+func (suite *HandlerTestSuite) TestGetPerson_VCardRedirect() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	canonicalUUID := "8ec028a9-a5e7-49ae-8bd5-7cd0a57df1d6"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPICompleteResponseTemplate, canonicalUUID, canonicalUUID, "")))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+".vcf", ""))
+	suite.Equal(http.StatusMovedPermanently, rec.Result().StatusCode)
+	suite.Equal("/people/"+canonicalUUID+".vcf", rec.Result().Header.Get("Location"))
+}
+
+func (suite *HandlerTestSuite) TestGetPerson_RDF() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, "")))
+
+	for mediaType, expected := range map[string]string{
+		"text/turtle":           "things:" + uuid + "\n    a <http://www.ft.com/ontology/core/Thing> ,",
+		"application/n-triples": "<http://api.ft.com/things/" + uuid + "> <http://www.w3.org/2004/02/skos/core#prefLabel> \"Neil Cole\" .\n",
+	} {
+		req := newRequest("GET", "/people/"+uuid, "")
+		req.Header.Set("Accept", mediaType)
+		rec := httptest.NewRecorder()
+		suite.router.ServeHTTP(rec, req)
+		suite.Equal(http.StatusOK, rec.Result().StatusCode, mediaType)
+		suite.Equal(mediaType+"; charset=UTF-8", rec.Result().Header.Get("Content-Type"))
+
+		body, _ := io.ReadAll(rec.Result().Body)
+		suite.Contains(string(body), expected, mediaType)
+		suite.Contains(string(body), "membershipOrganisation", mediaType)
+	}
+}
+
+func (suite *HandlerTestSuite) TestGetPerson_DescriptionFormat() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, "")))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"?descriptionFormat=text", ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+
+	ret := Person{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal("foobar", ret.DescriptionText)
+	suite.Empty(ret.DescriptionXML)
+
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people?uuid="+uuid+"&descriptionFormat=html", ""))
+	people := People{}
+	json.NewDecoder(rec.Result().Body).Decode(&people)
+	suite.Equal("foobar", people.People[uuid].Person.DescriptionHTML)
+
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"?descriptionFormat=markdown", ""))
+	errResp := ErrorResponse{}
+	json.NewDecoder(rec.Result().Body).Decode(&errResp)
+	suite.Equal(http.StatusBadRequest, rec.Result().StatusCode)
+	suite.Equal(descriptionFormatParam, errResp.Details.Parameter)
+}
+
+func (suite *HandlerTestSuite) TestGetPerson_NotAcceptable() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	req := newRequest("GET", "/people/"+uuid, "")
+	req.Header.Set("Accept", "text/html")
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+
+	ret := ErrorResponse{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(http.StatusNotAcceptable, rec.Result().StatusCode)
+	suite.Equal(codeNotAcceptable, ret.Code)
+	suite.Equal(0, httpmock.GetTotalCallCount())
+}
+
+func (suite *HandlerTestSuite) TestGetPerson_InvalidExpand() {
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	for _, path := range []string{"/people/" + uuid, "/people/" + uuid + "/memberships", "/people"} {
+		rec := httptest.NewRecorder()
+		suite.router.ServeHTTP(rec, newRequest("GET", path+"?uuid="+uuid+"&expand=roles", ""))
+
+		ret := ErrorResponse{}
+		json.NewDecoder(rec.Result().Body).Decode(&ret)
+		suite.Equal(http.StatusBadRequest, rec.Result().StatusCode, path)
+		suite.Equal(expandParam, ret.Details.Parameter, path)
+	}
+}
+
+func (suite *HandlerTestSuite) TestGetMemberships() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPIManyMembershipsResponseTemplate, uuid)))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"/memberships?sort=-startedAt&limit=2", ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+
+	ret := Memberships{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(3, ret.Total)
+	suite.Len(ret.Memberships, 2)
+	suite.Equal("Chairman", ret.Memberships[0].Title)
+	suite.Equal("Director", ret.Memberships[1].Title)
+	suite.Equal("/people/"+uuid+"/memberships?limit=2&sort=-startedAt", ret.Links.Self)
+	suite.Empty(ret.Links.Prev)
+
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", ret.Links.Next, ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+
+	ret = Memberships{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(3, ret.Total)
+	suite.Len(ret.Memberships, 1)
+	suite.Equal("Graduate Degree", ret.Memberships[0].Title)
+	suite.Empty(ret.Links.Next)
+	suite.Equal("/people/"+uuid+"/memberships?limit=2&sort=-startedAt", ret.Links.Prev)
+
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"/memberships?roleType=BoardRole&memberships=current&asOf=2018-01-01", ""))
+	ret = Memberships{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(1, ret.Total)
+	suite.Equal("Chairman", ret.Memberships[0].Title)
+
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"/memberships?organisation=1d448227-8b1b-3490-aeb8-18aa699d75f8", ""))
+	ret = Memberships{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(1, ret.Total)
+	suite.Equal("Graduate Degree", ret.Memberships[0].Title)
+}
+
+func (suite *HandlerTestSuite) TestGetTimeline() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPIManyMembershipsResponseTemplate, uuid)))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"/timeline", ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+	suite.NotEmpty(rec.Result().Header.Get("ETag"))
+
+	ret := Timeline{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.NotEmpty(ret.Events)
+	for i := 1; i < len(ret.Events); i++ {
+		prev, _ := parseEventDate(ret.Events[i-1].Date)
+		date, _ := parseEventDate(ret.Events[i].Date)
+		suite.False(date.Before(prev), ret.Events[i].Date)
+	}
+}
+
+func (suite *HandlerTestSuite) TestGetTimeline_NotFound() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(404, ""))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"/timeline", ""))
+	suite.Equal(http.StatusNotFound, rec.Result().StatusCode)
+}
+
+func (suite *HandlerTestSuite) TestGetMemberships_InvalidParameters() {
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	for query, param := range map[string]string{
+		"sort=title":           sortParam,
+		"limit=1000":           limitParam,
+		"cursor=abc":           cursorParam,
+		"organisation=ft":      organisationParam,
+		"memberships=upcoming": membershipsParam,
+	} {
+		rec := httptest.NewRecorder()
+		suite.router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid+"/memberships?"+query, ""))
+
+		ret := ErrorResponse{}
+		json.NewDecoder(rec.Result().Body).Decode(&ret)
+		suite.Equal(http.StatusBadRequest, rec.Result().StatusCode, query)
+		suite.Equal(&ErrorDetails{UUID: uuid, Parameter: param}, ret.Details, query)
+	}
+}
+
+func (suite *HandlerTestSuite) TestGetOrganisationPeople() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	orgUUID := "7a5d5b8e-1b8d-3a6c-9d2c-8f1d3c0b2f11"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+orgUUID, httpmock.NewStringResponder(200, conceptAPIOrganisationResponse))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/organisations/"+orgUUID+"/people", ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+
+	ret := OrganisationPeople{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(3, ret.Total)
+	suite.Len(ret.People, 3)
+
+	suite.Equal("Jane Doe", ret.People[0].Person.PrefLabel)
+	suite.Equal("Chief Executive", ret.People[0].Membership.Roles[0].PrefLabel)
+	suite.Equal("Neil Cole", ret.People[1].Person.PrefLabel)
+	suite.Equal("Director", ret.People[1].Membership.Title)
+	suite.Equal("Neil Cole", ret.People[2].Person.PrefLabel)
+	suite.Equal("Chairman", ret.People[2].Membership.Title)
+
+	suite.Equal(Thing{
+		ID:        "http://api.ft.com/things/60e54253-1e94-38df-83b1-a39804d1ac18",
+		APIURL:    "http://api.ft.com/people/60e54253-1e94-38df-83b1-a39804d1ac18",
+		PrefLabel: "Neil Cole",
+	}, ret.People[1].Person)
+	suite.Equal("http://api.ft.com/things/"+orgUUID, ret.People[1].Membership.Organisation.ID)
+	suite.Equal("Iconix Brand Group Inc", ret.People[1].Membership.Organisation.PrefLabel)
+
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/organisations/"+orgUUID+"/people?memberships=current&asOf=2018-01-01&limit=1", ""))
+	ret = OrganisationPeople{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(2, ret.Total)
+	suite.Len(ret.People, 1)
+	suite.Equal("Jane Doe", ret.People[0].Person.PrefLabel)
+	suite.NotEmpty(ret.Links.Next)
+}
+
+func (suite *HandlerTestSuite) TestGetOrganisationPeople_NotAnOrganisation() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, "")))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/organisations/"+uuid+"/people", ""))
+
+	ret := ErrorResponse{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(http.StatusNotFound, rec.Result().StatusCode)
+	suite.Equal(codeOrgNotFound, ret.Code)
+}
+
 func (suite *HandlerTestSuite) TestGetPeople_InternalError() {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
 
 	uuid := "70f4732b-7f7d-30a1-9c29-0cceec23760e"
-	httpmock.RegisterResponder("GET", "http://localhost:8080/people/"+uuid, httpmock.NewStringResponder(500, string("Some error")))
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, string("Some error")))
 
 	req := newRequest("GET", "/people/"+uuid, "")
 	rec := httptest.NewRecorder()
@@ -307,29 +866,535 @@ func (suite *HandlerTestSuite) TestGetPeople_InternalError() {
 	suite.Equal(http.StatusInternalServerError, rec.Result().StatusCode)
 }
 
-func (suite *HandlerTestSuite) TestGetPeople_MethodNotAllowedOnPost() {
+func (suite *HandlerTestSuite) TestGetPeople_UpstreamFailures() {
 	uuid := "70f4732b-7f7d-30a1-9c29-0cceec23760e"
-	req := newRequest("POST", "/people/"+uuid, "")
-	rec := httptest.NewRecorder()
-	suite.router.ServeHTTP(rec, req)
-	suite.Equal(http.StatusMethodNotAllowed, rec.Result().StatusCode)
-}
+	tests := []struct {
+		name           string
+		responder      httpmock.Responder
+		expectedStatus int
+		expectedMsg    string
+	}{
+		{"Gone", httpmock.NewStringResponder(http.StatusGone, "Gone"), http.StatusNotFound, personNotFoundMsg},
+		{"BadRequest", httpmock.NewStringResponder(http.StatusBadRequest, `{"message":"bad"}`), http.StatusBadGateway, conceptsAPIBadRequestMsg},
+		{"InternalServerError", httpmock.NewStringResponder(http.StatusInternalServerError, `{"message":"boom"}`), http.StatusServiceUnavailable, conceptsAPIUnavailableMsg},
+		{"ServiceUnavailable", httpmock.NewStringResponder(http.StatusServiceUnavailable, `{"message":"down"}`), http.StatusServiceUnavailable, conceptsAPIUnavailableMsg},
+		{"ConnectionFailure", httpmock.ConnectionFailure, http.StatusServiceUnavailable, conceptsAPIUnavailableMsg},
+		{"EmptyConcept", httpmock.NewStringResponder(http.StatusOK, `{}`), http.StatusInternalServerError, personUnableToBeRetrieved},
+	}
 
-func TestHandlersTestSuite(t *testing.T) {
-	suite.Run(t, new(HandlerTestSuite))
-}
+	for _, test := range tests {
+		httpmock.Activate()
+		httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, test.responder)
 
-func newRequest(method, url string, body string) *http.Request {
-	var payload io.Reader
-	if body != "" {
-		payload = bytes.NewReader([]byte(body))
-	}
-	req, err := http.NewRequest(method, url, payload)
+		req := newRequest("GET", "/people/"+uuid, "")
+		rec := httptest.NewRecorder()
+		suite.router.ServeHTTP(rec, req)
 
-	if err != nil {
-		panic(err)
+		returnMsg := &errMsg{}
+		json.NewDecoder(rec.Result().Body).Decode(returnMsg)
+		suite.Equal(&errMsg{Message: test.expectedMsg}, returnMsg, test.name)
+		suite.Equal(test.expectedStatus, rec.Result().StatusCode, test.name)
+		httpmock.DeactivateAndReset()
 	}
-	return req
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_ErrorResponses() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	missingUUID := "2d3e16e0-61cb-4322-8aff-3b01c59f4daa"
+	concordedUUID := "70f4732b-7f7d-30a1-9c29-0cceec23760e"
+	canonicalUUID := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+missingUUID, httpmock.NewStringResponder(404, "Not found"))
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+concordedUUID, httpmock.NewStringResponder(200, `{
+		"id": "http://www.ft.com/thing/60e54253-1e94-38df-83b1-a39804d1ac18",
+		"apiUrl": "http://api.ft.com/people/60e54253-1e94-38df-83b1-a39804d1ac18",
+		"prefLabel": "Neil Cole",
+		"type": "http://www.ft.com/ontology/person/Person"
+	}`))
+
+	tests := []struct {
+		path           string
+		expectedStatus int
+		expected       ErrorResponse
+	}{
+		{
+			path:           "/people/" + missingUUID,
+			expectedStatus: http.StatusNotFound,
+			expected: ErrorResponse{
+				Code:          codePersonNotFound,
+				Message:       personNotFoundMsg,
+				TransactionID: "tid_test",
+				Details:       &ErrorDetails{UUID: missingUUID},
+			},
+		},
+		{
+			path:           "/people/" + concordedUUID,
+			expectedStatus: http.StatusMovedPermanently,
+			expected: ErrorResponse{
+				Code:          codePersonConcorded,
+				Message:       fmt.Sprintf(redirectedPerson, concordedUUID, canonicalUUID),
+				TransactionID: "tid_test",
+				Details:       &ErrorDetails{UUID: concordedUUID, CanonicalUUID: canonicalUUID},
+			},
+		},
+		{
+			path:           "/people/<script>",
+			expectedStatus: http.StatusBadRequest,
+			expected: ErrorResponse{
+				Code:          codeInvalidUUID,
+				Message:       badRequestMsg,
+				TransactionID: "tid_test",
+				Details:       &ErrorDetails{UUID: "<script>"},
+			},
+		},
+		{
+			path:           "/people",
+			expectedStatus: http.StatusBadRequest,
+			expected: ErrorResponse{
+				Code:          codeNoUUIDs,
+				Message:       noUUIDsMsg,
+				TransactionID: "tid_test",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		req := newRequest("GET", test.path, "")
+		req.Header.Set("X-Request-Id", "tid_test")
+		rec := httptest.NewRecorder()
+		suite.router.ServeHTTP(rec, req)
+
+		ret := ErrorResponse{}
+		suite.NoError(json.NewDecoder(rec.Result().Body).Decode(&ret), test.path)
+		suite.Equal(test.expected, ret, test.path)
+		suite.Equal(test.expectedStatus, rec.Result().StatusCode, test.path)
+		suite.Equal(contentTypeJson, rec.Result().Header.Get("Content-Type"), test.path)
+	}
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_MethodNotAllowedOnPost() {
+	uuid := "70f4732b-7f7d-30a1-9c29-0cceec23760e"
+	req := newRequest("POST", "/people/"+uuid, "")
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+	suite.Equal(http.StatusMethodNotAllowed, rec.Result().StatusCode)
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_Cached() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	handler := NewHandler(HandlerConfig{
+		CacheDuration:        time.Minute,
+		PublicConceptsApiURL: "http://localhost:8080",
+		CacheSize:            10,
+	}, http.DefaultClient)
+	router := mux.NewRouter()
+	handler.RegisterHandlers(router)
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	missingUUID := "2d3e16e0-61cb-4322-8aff-3b01c59f4daa"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(200, fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, "")))
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+missingUUID, httpmock.NewStringResponder(404, "Not found"))
+
+	for i := 0; i < 3; i++ {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid, ""))
+		retPerson := Person{}
+		json.NewDecoder(rec.Result().Body).Decode(&retPerson)
+		suite.Equal(http.StatusOK, rec.Result().StatusCode)
+		suite.Equal(getExpectedPerson(uuid, false), retPerson)
+
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, newRequest("GET", "/people/"+missingUUID, ""))
+		suite.Equal(http.StatusNotFound, rec.Result().StatusCode)
+	}
+
+	suite.Equal(2, httpmock.GetTotalCallCount())
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_RetriesUnavailableUpstream() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	handler := NewHandler(HandlerConfig{
+		PublicConceptsApiURL: "http://localhost:8080",
+		UpstreamRetries:      2,
+		UpstreamRetryBackoff: time.Millisecond,
+	}, http.DefaultClient)
+	router := mux.NewRouter()
+	handler.RegisterHandlers(router)
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	calls := 0
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls < 3 {
+			return httpmock.NewStringResponse(http.StatusServiceUnavailable, "unavailable"), nil
+		}
+		return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(conceptAPICompleteResponseTemplate, uuid, uuid, "")), nil
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid, ""))
+
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+	suite.Equal(3, calls)
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_DoesNotRetryNotFound() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	handler := NewHandler(HandlerConfig{
+		PublicConceptsApiURL: "http://localhost:8080",
+		UpstreamRetries:      2,
+		UpstreamRetryBackoff: time.Millisecond,
+	}, http.DefaultClient)
+	router := mux.NewRouter()
+	handler.RegisterHandlers(router)
+
+	uuid := "2d3e16e0-61cb-4322-8aff-3b01c59f4daa"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(404, "Not found"))
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid, ""))
+
+	suite.Equal(http.StatusNotFound, rec.Result().StatusCode)
+	suite.Equal(1, httpmock.GetTotalCallCount())
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_CircuitBreakerFailsFast() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	handler := NewHandler(HandlerConfig{
+		PublicConceptsApiURL: "http://localhost:8080",
+		BreakerThreshold:     2,
+		BreakerCooldown:      time.Minute,
+	}, http.DefaultClient)
+	router := mux.NewRouter()
+	handler.RegisterHandlers(router)
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(http.StatusBadGateway, "bad gateway"))
+
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid, ""))
+		suite.Equal(http.StatusServiceUnavailable, rec.Result().StatusCode)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid, ""))
+	suite.Equal(http.StatusServiceUnavailable, rec.Result().StatusCode)
+	suite.Equal(2, httpmock.GetTotalCallCount())
+
+	_, err := handler.CircuitBreakerCheck().Checker()
+	suite.Error(err)
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_CircuitBreakerCountsRetriedRequestOnce() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	handler := NewHandler(HandlerConfig{
+		PublicConceptsApiURL: "http://localhost:8080",
+		UpstreamRetries:      2,
+		UpstreamRetryBackoff: time.Millisecond,
+		BreakerThreshold:     2,
+		BreakerCooldown:      time.Minute,
+	}, http.DefaultClient)
+	router := mux.NewRouter()
+	handler.RegisterHandlers(router)
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, httpmock.NewStringResponder(http.StatusBadGateway, "bad gateway"))
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid, ""))
+	suite.Equal(3, httpmock.GetTotalCallCount())
+	_, err := handler.CircuitBreakerCheck().Checker()
+	suite.NoError(err, "a request failing after its retries should count as a single failure")
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid, ""))
+	suite.Equal(6, httpmock.GetTotalCallCount())
+	_, err = handler.CircuitBreakerCheck().Checker()
+	suite.Error(err)
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_UpstreamTimeout() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	handler := NewHandler(HandlerConfig{
+		PublicConceptsApiURL: "http://localhost:8080",
+		UpstreamTimeout:      10 * time.Millisecond,
+	}, http.DefaultClient)
+	router := mux.NewRouter()
+	handler.RegisterHandlers(router)
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newRequest("GET", "/people/"+uuid, ""))
+
+	returnMsg := &errMsg{}
+	json.NewDecoder(rec.Result().Body).Decode(returnMsg)
+	suite.Equal(&errMsg{Message: personRetrievalTimedOut}, returnMsg)
+	suite.Equal(http.StatusGatewayTimeout, rec.Result().StatusCode)
+}
+
+func (suite *HandlerTestSuite) TestGetPeople_ClientGoneCancelsUpstream() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	uuid := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	upstreamCancelled := make(chan struct{})
+	var lookup *flight
+	ctx, cancel := context.WithCancel(context.Background())
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+uuid, func(req *http.Request) (*http.Response, error) {
+		suite.handler.inflight.mu.Lock()
+		lookup = suite.handler.inflight.flights[uuid]
+		suite.handler.inflight.mu.Unlock()
+		cancel()
+		<-req.Context().Done()
+		close(upstreamCancelled)
+		return nil, req.Context().Err()
+	})
+
+	req := newRequest("GET", "/people/"+uuid, "").WithContext(ctx)
+	suite.router.ServeHTTP(httptest.NewRecorder(), req)
+
+	select {
+	case <-upstreamCancelled:
+	case <-time.After(5 * time.Second):
+		suite.Fail("the request to the concepts API should have been cancelled")
+	}
+	<-lookup.done
+}
+
+func (suite *HandlerTestSuite) TestGetPeopleBatch_PartialResults() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	foundUUID := "60e54253-1e94-38df-83b1-a39804d1ac18"
+	missingUUID := "2d3e16e0-61cb-4322-8aff-3b01c59f4daa"
+	concordedUUID := "70f4732b-7f7d-30a1-9c29-0cceec23760e"
+	failingUUID := "8ec028a9-a5e7-49ae-8bd5-7cd0a57df1d6"
+
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+foundUUID, httpmock.NewStringResponder(200, `{
+		"id": "http://www.ft.com/thing/60e54253-1e94-38df-83b1-a39804d1ac18",
+		"apiUrl": "http://api.ft.com/people/60e54253-1e94-38df-83b1-a39804d1ac18",
+		"prefLabel": "Neil Cole",
+		"type": "http://www.ft.com/ontology/person/Person"
+	}`))
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+missingUUID, httpmock.NewStringResponder(404, "Not found"))
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+concordedUUID, httpmock.NewStringResponder(200, `{
+		"id": "http://www.ft.com/thing/60e54253-1e94-38df-83b1-a39804d1ac18",
+		"apiUrl": "http://api.ft.com/people/60e54253-1e94-38df-83b1-a39804d1ac18",
+		"prefLabel": "Neil Cole",
+		"type": "http://www.ft.com/ontology/person/Person"
+	}`))
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts/"+failingUUID, httpmock.NewStringResponder(200, "not json"))
+
+	req := newRequest("GET", fmt.Sprintf("/people?uuid=%s&uuid=%s&uuid=%s&uuid=%s&uuid=%s", foundUUID, missingUUID, concordedUUID, failingUUID, foundUUID), "")
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+
+	ret := People{}
+	suite.NoError(json.NewDecoder(rec.Result().Body).Decode(&ret))
+	suite.Len(ret.People, 4)
+
+	suite.Equal(http.StatusOK, ret.People[foundUUID].Status)
+	suite.Equal("Neil Cole", ret.People[foundUUID].Person.PrefLabel)
+
+	suite.Equal(PersonResult{Status: http.StatusNotFound, Code: codePersonNotFound, Message: personNotFoundMsg}, ret.People[missingUUID])
+
+	suite.Equal(http.StatusMovedPermanently, ret.People[concordedUUID].Status)
+	suite.Equal(codePersonConcorded, ret.People[concordedUUID].Code)
+	suite.Equal("/people/"+foundUUID, ret.People[concordedUUID].Location)
+	suite.Equal(fmt.Sprintf(redirectedPerson, concordedUUID, foundUUID), ret.People[concordedUUID].Message)
+
+	suite.Equal(PersonResult{Status: http.StatusInternalServerError, Code: codeInternalError, Message: personUnableToBeRetrieved}, ret.People[failingUUID])
+}
+
+func (suite *HandlerTestSuite) TestGetPeopleBatch_NoUUIDs() {
+	req := newRequest("GET", "/people", "")
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+
+	returnMsg := &errMsg{}
+	json.NewDecoder(rec.Result().Body).Decode(returnMsg)
+	suite.Equal(&errMsg{Message: noUUIDsMsg}, returnMsg)
+	suite.Equal(http.StatusBadRequest, rec.Result().StatusCode)
+}
+
+func (suite *HandlerTestSuite) TestGetPeopleBatch_InvalidUUID() {
+	req := newRequest("GET", "/people?uuid=60e54253-1e94-38df-83b1-a39804d1ac18&uuid=BOO", "")
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+
+	returnMsg := &errMsg{}
+	json.NewDecoder(rec.Result().Body).Decode(returnMsg)
+	suite.Equal(&errMsg{Message: badRequestMsg}, returnMsg)
+	suite.Equal(http.StatusBadRequest, rec.Result().StatusCode)
+}
+
+func (suite *HandlerTestSuite) TestGetPeopleBatch_TooManyUUIDs() {
+	q := url.Values{}
+	for i := 0; i <= maxBatchSize; i++ {
+		q.Add("uuid", fmt.Sprintf("60e54253-1e94-38df-83b1-%012d", i))
+	}
+	req := newRequest("GET", "/people?"+q.Encode(), "")
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, req)
+
+	returnMsg := &errMsg{}
+	json.NewDecoder(rec.Result().Body).Decode(returnMsg)
+	suite.Equal(&errMsg{Message: fmt.Sprintf(tooManyUUIDsMsg, maxBatchSize)}, returnMsg)
+	suite.Equal(http.StatusBadRequest, rec.Result().StatusCode)
+}
+
+func (suite *HandlerTestSuite) TestSearchPeople() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts", func(req *http.Request) (*http.Response, error) {
+		suite.Equal("neil", req.URL.Query().Get("q"))
+		suite.Equal("http://www.ft.com/ontology/person/Person", req.URL.Query().Get("type"))
+		suite.Equal("search", req.URL.Query().Get("mode"))
+		return httpmock.NewStringResponse(200, `{"concepts": [
+			{"id": "http://www.ft.com/thing/2", "apiUrl": "http://api.ft.com/people/2", "prefLabel": "Mary Neilson", "type": "http://www.ft.com/ontology/person/Person"},
+			{"id": "http://www.ft.com/thing/1", "apiUrl": "http://api.ft.com/people/1", "prefLabel": "Neil Cole", "type": "http://www.ft.com/ontology/person/Person"},
+			{"id": "http://www.ft.com/thing/3", "apiUrl": "http://api.ft.com/people/3", "prefLabel": "Jim Smith", "type": "http://www.ft.com/ontology/person/Person",
+				"alternativeLabels": [{"type": "http://www.ft.com/ontology/Alias", "value": "Neil Smith"}]}
+		]}`), nil
+	})
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people?q=neil&limit=2", ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+
+	ret := SearchResults{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(3, ret.Total)
+	suite.Equal([]Thing{
+		{ID: "http://api.ft.com/things/1", APIURL: "http://api.ft.com/people/1", PrefLabel: "Neil Cole"},
+		{ID: "http://api.ft.com/things/3", APIURL: "http://api.ft.com/people/3", PrefLabel: "Jim Smith"},
+	}, ret.People)
+	suite.NotEmpty(ret.Links.Next)
+
+	rec = httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", ret.Links.Next, ""))
+	ret = SearchResults{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Len(ret.People, 1)
+	suite.Equal("Mary Neilson", ret.People[0].PrefLabel)
+	suite.Empty(ret.Links.Next)
+}
+
+func (suite *HandlerTestSuite) TestSearchPeople_NoMatches() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts", httpmock.NewStringResponder(404, ""))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people?q=nobody", ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+
+	ret := SearchResults{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(0, ret.Total)
+	suite.NotNil(ret.People)
+}
+
+func (suite *HandlerTestSuite) TestSearchPeople_KeepsEveryMatchOfTheSearcher() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts", httpmock.NewStringResponder(200, `{"concepts": [
+		{"id": "http://www.ft.com/thing/1", "apiUrl": "http://api.ft.com/people/1", "prefLabel": "Zoë Ball", "type": "http://www.ft.com/ontology/person/Person"},
+		{"id": "http://www.ft.com/thing/2", "apiUrl": "http://api.ft.com/people/2", "prefLabel": "Zoe Smith", "type": "http://www.ft.com/ontology/person/Person"}
+	]}`))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people?q=zoe", ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+
+	ret := SearchResults{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(2, ret.Total)
+	suite.Equal("Zoe Smith", ret.People[0].PrefLabel)
+	suite.Equal("Zoë Ball", ret.People[1].PrefLabel)
+}
+
+func (suite *HandlerTestSuite) TestSearchPeople_UpstreamUnavailable() {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts", httpmock.NewStringResponder(503, ""))
+
+	rec := httptest.NewRecorder()
+	suite.router.ServeHTTP(rec, newRequest("GET", "/people?q=neil", ""))
+	suite.Equal(http.StatusServiceUnavailable, rec.Result().StatusCode)
+}
+
+func (suite *HandlerTestSuite) TestSearchPeople_InvalidParameters() {
+	for _, query := range []string{"q=", "q=neil&uuid=60e54253-1e94-38df-83b1-a39804d1ac18", "q=neil&limit=0"} {
+		rec := httptest.NewRecorder()
+		suite.router.ServeHTTP(rec, newRequest("GET", "/people?"+query, ""))
+		suite.Equal(http.StatusBadRequest, rec.Result().StatusCode, query)
+
+		ret := ErrorResponse{}
+		json.NewDecoder(rec.Result().Body).Decode(&ret)
+		suite.Equal(codeInvalidParameter, ret.Code, query)
+	}
+}
+
+func (suite *HandlerTestSuite) TestSearchPeople_CustomSearcher() {
+	handler := NewHandler(HandlerConfig{Searcher: NewMemorySearcher(
+		Person{Thing: Thing{ID: "http://api.ft.com/things/1", PrefLabel: "Neil Cole"}},
+		Person{Thing: Thing{ID: "http://api.ft.com/things/2", PrefLabel: "Jane Doe"}, Labels: []string{"Jane Cole"}},
+	)}, http.DefaultClient)
+	router := mux.NewRouter()
+	handler.RegisterHandlers(router)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newRequest("GET", "/people?q=cole", ""))
+	suite.Equal(http.StatusOK, rec.Result().StatusCode)
+
+	ret := SearchResults{}
+	json.NewDecoder(rec.Result().Body).Decode(&ret)
+	suite.Equal(2, ret.Total)
+	suite.Equal("Neil Cole", ret.People[0].PrefLabel)
+	suite.Equal("Jane Doe", ret.People[1].PrefLabel)
+}
+
+func TestHandlersTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func newRequest(method, url string, body string) *http.Request {
+	var payload io.Reader
+	if body != "" {
+		payload = bytes.NewReader([]byte(body))
+	}
+	req, err := http.NewRequest(method, url, payload)
+
+	if err != nil {
+		panic(err)
+	}
+	return req
 }
 
 type errMsg struct {
@@ -428,3 +1493,203 @@ var conceptAPICompleteResponseTemplate = `{
     }
   ]
 }`
+
+var conceptAPIManyMembershipsResponseTemplate = `{
+  "id": "http://www.ft.com/thing/%s",
+  "apiUrl": "http://api.ft.com/people/%[1]s",
+  "type": "http://www.ft.com/ontology/person/Person",
+  "prefLabel": "Neil Cole",
+  "relatedConcepts": [
+    {
+      "concept": {
+        "id": "http://www.ft.com/thing/ea3e354e-13dc-3287-8950-230f3c6416d0",
+        "type": "http://www.ft.com/ontology/organisation/Membership",
+        "prefLabel": "Graduate Degree",
+        "changeEvents": [{"startedAt": "1979-01-01"}, {"endedAt": "1982-01-01"}],
+        "relatedConcepts": [
+          {
+            "concept": {
+              "id": "http://www.ft.com/thing/1d448227-8b1b-3490-aeb8-18aa699d75f8",
+              "apiUrl": "http://api.ft.com/concepts/1d448227-8b1b-3490-aeb8-18aa699d75f8",
+              "type": "http://www.ft.com/ontology/organisation/Organisation",
+              "prefLabel": "Maurice A. Deane School of Law at Hofstra University"
+            },
+            "predicate": "http://www.ft.com/ontology/membershipOrganisation"
+          },
+          {
+            "concept": {
+              "id": "http://www.ft.com/thing/c89c1b9e-2bc5-3dbd-bcc5-595d2dabb4bd",
+              "apiUrl": "http://api.ft.com/concepts/c89c1b9e-2bc5-3dbd-bcc5-595d2dabb4bd",
+              "type": "http://www.ft.com/ontology/MembershipRole",
+              "prefLabel": "Graduate Degree"
+            },
+            "predicate": "http://www.ft.com/ontology/membershipRole"
+          }
+        ]
+      },
+      "predicate": "http://www.ft.com/ontology/membership"
+    },
+    {
+      "concept": {
+        "id": "http://www.ft.com/thing/5a3e354e-13dc-3287-8950-230f3c6416d1",
+        "type": "http://www.ft.com/ontology/organisation/Membership",
+        "prefLabel": "Chairman",
+        "changeEvents": [{"startedAt": "2011-05-01"}],
+        "relatedConcepts": [
+          {
+            "concept": {
+              "id": "http://www.ft.com/thing/7a5d5b8e-1b8d-3a6c-9d2c-8f1d3c0b2f11",
+              "apiUrl": "http://api.ft.com/concepts/7a5d5b8e-1b8d-3a6c-9d2c-8f1d3c0b2f11",
+              "type": "http://www.ft.com/ontology/company/PublicCompany",
+              "prefLabel": "Iconix Brand Group Inc"
+            },
+            "predicate": "http://www.ft.com/ontology/membershipOrganisation"
+          },
+          {
+            "concept": {
+              "id": "http://www.ft.com/thing/2c1d3f4e-5a6b-3c7d-8e9f-0a1b2c3d4e5f",
+              "apiUrl": "http://api.ft.com/concepts/2c1d3f4e-5a6b-3c7d-8e9f-0a1b2c3d4e5f",
+              "type": "http://www.ft.com/ontology/organisation/BoardRole",
+              "prefLabel": "Chairman of the Board"
+            },
+            "predicate": "http://www.ft.com/ontology/membershipRole"
+          }
+        ]
+      },
+      "predicate": "http://www.ft.com/ontology/membership"
+    },
+    {
+      "concept": {
+        "id": "http://www.ft.com/thing/6b3e354e-13dc-3287-8950-230f3c6416d2",
+        "type": "http://www.ft.com/ontology/organisation/Membership",
+        "prefLabel": "Director",
+        "changeEvents": [{"startedAt": "1993-01-01"}, {"endedAt": "2008-06-30"}],
+        "relatedConcepts": [
+          {
+            "concept": {
+              "id": "http://www.ft.com/thing/7a5d5b8e-1b8d-3a6c-9d2c-8f1d3c0b2f11",
+              "apiUrl": "http://api.ft.com/concepts/7a5d5b8e-1b8d-3a6c-9d2c-8f1d3c0b2f11",
+              "type": "http://www.ft.com/ontology/company/PublicCompany",
+              "prefLabel": "Iconix Brand Group Inc"
+            },
+            "predicate": "http://www.ft.com/ontology/membershipOrganisation"
+          },
+          {
+            "concept": {
+              "id": "http://www.ft.com/thing/3d2e4f5a-6b7c-3d8e-9f0a-1b2c3d4e5f60",
+              "apiUrl": "http://api.ft.com/concepts/3d2e4f5a-6b7c-3d8e-9f0a-1b2c3d4e5f60",
+              "type": "http://www.ft.com/ontology/organisation/BoardRole",
+              "prefLabel": "Director"
+            },
+            "predicate": "http://www.ft.com/ontology/membershipRole"
+          }
+        ]
+      },
+      "predicate": "http://www.ft.com/ontology/membership"
+    }
+  ]
+}`
+
+var conceptAPIOrganisationResponse = `{
+  "id": "http://www.ft.com/thing/7a5d5b8e-1b8d-3a6c-9d2c-8f1d3c0b2f11",
+  "apiUrl": "http://api.ft.com/organisations/7a5d5b8e-1b8d-3a6c-9d2c-8f1d3c0b2f11",
+  "type": "http://www.ft.com/ontology/company/PublicCompany",
+  "prefLabel": "Iconix Brand Group Inc",
+  "relatedConcepts": [
+    {
+      "concept": {
+        "id": "http://www.ft.com/thing/5a3e354e-13dc-3287-8950-230f3c6416d1",
+        "type": "http://www.ft.com/ontology/organisation/Membership",
+        "prefLabel": "Chairman",
+        "changeEvents": [{"startedAt": "2011-05-01"}],
+        "relatedConcepts": [
+          {
+            "concept": {
+              "id": "http://www.ft.com/thing/60e54253-1e94-38df-83b1-a39804d1ac18",
+              "apiUrl": "http://api.ft.com/concepts/60e54253-1e94-38df-83b1-a39804d1ac18",
+              "type": "http://www.ft.com/ontology/person/Person",
+              "prefLabel": "Neil Cole"
+            },
+            "predicate": "http://www.ft.com/ontology/membershipPerson"
+          },
+          {
+            "concept": {
+              "id": "http://www.ft.com/thing/2c1d3f4e-5a6b-3c7d-8e9f-0a1b2c3d4e5f",
+              "apiUrl": "http://api.ft.com/concepts/2c1d3f4e-5a6b-3c7d-8e9f-0a1b2c3d4e5f",
+              "type": "http://www.ft.com/ontology/organisation/BoardRole",
+              "prefLabel": "Director"
+            },
+            "predicate": "http://www.ft.com/ontology/membershipRole"
+          }
+        ]
+      },
+      "predicate": "http://www.ft.com/ontology/membership"
+    },
+    {
+      "concept": {
+        "id": "http://www.ft.com/thing/6b3e354e-13dc-3287-8950-230f3c6416d2",
+        "type": "http://www.ft.com/ontology/organisation/Membership",
+        "prefLabel": "Director",
+        "changeEvents": [{"startedAt": "1993-01-01"}, {"endedAt": "2008-06-30"}],
+        "relatedConcepts": [
+          {
+            "concept": {
+              "id": "http://www.ft.com/thing/60e54253-1e94-38df-83b1-a39804d1ac18",
+              "apiUrl": "http://api.ft.com/concepts/60e54253-1e94-38df-83b1-a39804d1ac18",
+              "type": "http://www.ft.com/ontology/person/Person",
+              "prefLabel": "Neil Cole"
+            },
+            "predicate": "http://www.ft.com/ontology/membershipPerson"
+          },
+          {
+            "concept": {
+              "id": "http://www.ft.com/thing/3d2e4f5a-6b7c-3d8e-9f0a-1b2c3d4e5f60",
+              "apiUrl": "http://api.ft.com/concepts/3d2e4f5a-6b7c-3d8e-9f0a-1b2c3d4e5f60",
+              "type": "http://www.ft.com/ontology/organisation/BoardRole",
+              "prefLabel": "Director"
+            },
+            "predicate": "http://www.ft.com/ontology/membershipRole"
+          }
+        ]
+      },
+      "predicate": "http://www.ft.com/ontology/membership"
+    },
+    {
+      "concept": {
+        "id": "http://www.ft.com/thing/8c3e354e-13dc-3287-8950-230f3c6416d3",
+        "type": "http://www.ft.com/ontology/organisation/Membership",
+        "prefLabel": "Chief Executive Officer",
+        "changeEvents": [{"startedAt": "2015-01-01"}],
+        "relatedConcepts": [
+          {
+            "concept": {
+              "id": "http://www.ft.com/thing/9d4e354e-13dc-3287-8950-230f3c6416d4",
+              "apiUrl": "http://api.ft.com/concepts/9d4e354e-13dc-3287-8950-230f3c6416d4",
+              "type": "http://www.ft.com/ontology/person/Person",
+              "prefLabel": "Jane Doe"
+            },
+            "predicate": "http://www.ft.com/ontology/membershipPerson"
+          },
+          {
+            "concept": {
+              "id": "http://www.ft.com/thing/4e2e4f5a-6b7c-3d8e-9f0a-1b2c3d4e5f61",
+              "apiUrl": "http://api.ft.com/concepts/4e2e4f5a-6b7c-3d8e-9f0a-1b2c3d4e5f61",
+              "type": "http://www.ft.com/ontology/MembershipRole",
+              "prefLabel": "Chief Executive"
+            },
+            "predicate": "http://www.ft.com/ontology/membershipRole"
+          }
+        ]
+      },
+      "predicate": "http://www.ft.com/ontology/membership"
+    },
+    {
+      "concept": {
+        "id": "http://www.ft.com/thing/1a2b3c4d-13dc-3287-8950-230f3c6416d5",
+        "type": "http://www.ft.com/ontology/company/PublicCompany",
+        "prefLabel": "Iconix Subsidiary"
+      },
+      "predicate": "http://www.ft.com/ontology/subOrganisationOf"
+    }
+  ]
+}`
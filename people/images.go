@@ -0,0 +1,75 @@
+package people
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	imageURLPlaceholder    = "{url}"
+	imageWidthPlaceholder  = "{width}"
+	imageHeightPlaceholder = "{height}"
+	imageFormatPlaceholder = "{format}"
+)
+
+// imageService builds the renditions of an image from a URL template of an image service, such as
+// https://www.ft.com/__origami/service/image/v2/images/raw/{url}?width={width}&height={height}&format={format}.
+// A nil *imageService builds no image sets.
+type imageService struct {
+	template   string
+	renditions []ImageRendition
+}
+
+func newImageService(template string, renditions []ImageRendition) *imageService {
+	if template == "" || len(renditions) == 0 {
+		return nil
+	}
+	return &imageService{template: template, renditions: renditions}
+}
+
+// imageSet returns the renditions of the image at source, or nil if there is no image
+func (s *imageService) imageSet(source string) *ImageSet {
+	if s == nil || source == "" {
+		return nil
+	}
+	set := ImageSet{Renditions: make([]ImageRendition, len(s.renditions))}
+	for i, r := range s.renditions {
+		r.URL = strings.NewReplacer(
+			imageURLPlaceholder, url.QueryEscape(source),
+			imageWidthPlaceholder, strconv.Itoa(r.Width),
+			imageHeightPlaceholder, strconv.Itoa(r.Height),
+			imageFormatPlaceholder, r.Format,
+		).Replace(s.template)
+		set.Renditions[i] = r
+	}
+	return &set
+}
+
+// ParseImageRenditions parses a comma separated list of renditions of the form
+// WIDTHxHEIGHT.FORMAT, such as 150x150.jpg,300x300.webp
+func ParseImageRenditions(s string) ([]ImageRendition, error) {
+	var renditions []ImageRendition
+	for _, spec := range strings.Split(s, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		size, format, ok := strings.Cut(spec, ".")
+		width, height, sized := strings.Cut(size, "x")
+		if !ok || !sized || format == "" {
+			return nil, fmt.Errorf("rendition %q is not of the form WIDTHxHEIGHT.FORMAT", spec)
+		}
+		r := ImageRendition{Format: format}
+		var err error
+		if r.Width, err = strconv.Atoi(width); err != nil || r.Width <= 0 {
+			return nil, fmt.Errorf("rendition %q has an invalid width", spec)
+		}
+		if r.Height, err = strconv.Atoi(height); err != nil || r.Height <= 0 {
+			return nil, fmt.Errorf("rendition %q has an invalid height", spec)
+		}
+		renditions = append(renditions, r)
+	}
+	return renditions, nil
+}
@@ -0,0 +1,43 @@
+package people
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImageRenditions(t *testing.T) {
+	renditions, err := ParseImageRenditions("150x150.jpg, 600x400.webp,")
+	assert.NoError(t, err)
+	assert.Equal(t, []ImageRendition{
+		{Width: 150, Height: 150, Format: "jpg"},
+		{Width: 600, Height: 400, Format: "webp"},
+	}, renditions)
+
+	renditions, err = ParseImageRenditions("")
+	assert.NoError(t, err)
+	assert.Empty(t, renditions)
+
+	for _, spec := range []string{"150x150", "150.jpg", "x150.jpg", "0x150.jpg", "150xabc.png", "150x150."} {
+		_, err := ParseImageRenditions(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestImageService_ImageSet(t *testing.T) {
+	images := newImageService("https://images.example.com/{url}?w={width}&h={height}&f={format}", []ImageRendition{
+		{Width: 150, Height: 150, Format: "jpg"},
+		{Width: 600, Height: 400, Format: "webp"},
+	})
+
+	assert.Equal(t, &ImageSet{Renditions: []ImageRendition{
+		{Width: 150, Height: 150, Format: "jpg", URL: "https://images.example.com/https%3A%2F%2Fexample.com%2Fhead.png%3Fv%3D1?w=150&h=150&f=jpg"},
+		{Width: 600, Height: 400, Format: "webp", URL: "https://images.example.com/https%3A%2F%2Fexample.com%2Fhead.png%3Fv%3D1?w=600&h=400&f=webp"},
+	}}, images.imageSet("https://example.com/head.png?v=1"))
+	assert.Nil(t, images.imageSet(""))
+
+	assert.Nil(t, newImageService("", []ImageRendition{{Width: 150, Height: 150, Format: "jpg"}}))
+	assert.Nil(t, newImageService("https://images.example.com/{url}", nil))
+	var disabled *imageService
+	assert.Nil(t, disabled.imageSet("https://example.com/head.png"))
+}
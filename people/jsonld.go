@@ -0,0 +1,113 @@
+package people
+
+import (
+	"strconv"
+	"strings"
+)
+
+const schemaOrgContext = "https://schema.org"
+
+// jsonLDPerson is a schema.org Person. Memberships are OrganizationRoles holding the organisation,
+// as schema.org recommends for qualifying the worksFor relationship.
+type jsonLDPerson struct {
+	Context         string       `json:"@context"`
+	Type            string       `json:"@type"`
+	ID              string       `json:"@id"`
+	Name            string       `json:"name,omitempty"`
+	AlternateName   []string     `json:"alternateName,omitempty"`
+	HonorificPrefix string       `json:"honorificPrefix,omitempty"`
+	Description     string       `json:"description,omitempty"`
+	Image           string       `json:"image,omitempty"`
+	Email           string       `json:"email,omitempty"`
+	BirthDate       string       `json:"birthDate,omitempty"`
+	SameAs          []string     `json:"sameAs,omitempty"`
+	WorksFor        []jsonLDRole `json:"worksFor,omitempty"`
+}
+
+type jsonLDRole struct {
+	Type      string             `json:"@type"`
+	Name      string             `json:"name,omitempty"`
+	RoleName  []string           `json:"roleName,omitempty"`
+	StartDate string             `json:"startDate,omitempty"`
+	EndDate   string             `json:"endDate,omitempty"`
+	WorksFor  jsonLDOrganisation `json:"worksFor"`
+}
+
+type jsonLDOrganisation struct {
+	Type          string   `json:"@type"`
+	ID            string   `json:"@id,omitempty"`
+	Name          string   `json:"name,omitempty"`
+	AlternateName []string `json:"alternateName,omitempty"`
+	LeiCode       string   `json:"leiCode,omitempty"`
+}
+
+// toJSONLD maps p to schema.org
+func toJSONLD(p Person) jsonLDPerson {
+	ld := jsonLDPerson{
+		Context:         schemaOrgContext,
+		Type:            "Person",
+		ID:              p.ID,
+		Name:            p.PrefLabel,
+		AlternateName:   p.Labels,
+		HonorificPrefix: p.Salutation,
+		Description:     p.Description,
+		Image:           p.ImageURL,
+		Email:           p.EmailAddress,
+	}
+	if p.BirthYear > 0 {
+		ld.BirthDate = strconv.Itoa(p.BirthYear)
+	}
+	if p.TwitterHandle != "" {
+		ld.SameAs = append(ld.SameAs, "https://twitter.com/"+strings.TrimPrefix(p.TwitterHandle, "@"))
+	}
+	if p.FacebookProfile != "" {
+		ld.SameAs = append(ld.SameAs, p.FacebookProfile)
+	}
+
+	for _, m := range p.Memberships {
+		role := jsonLDRole{
+			Type:      "OrganizationRole",
+			Name:      m.Title,
+			StartDate: eventDate(m.ChangeEvents, true),
+			EndDate:   eventDate(m.ChangeEvents, false),
+			WorksFor: jsonLDOrganisation{
+				Type:          "Organization",
+				ID:            m.Organisation.ID,
+				Name:          m.Organisation.PrefLabel,
+				AlternateName: m.Organisation.Labels,
+				LeiCode:       m.Organisation.LeiCode,
+			},
+		}
+		for _, r := range m.Roles {
+			role.RoleName = append(role.RoleName, r.PrefLabel)
+		}
+		ld.WorksFor = append(ld.WorksFor, role)
+	}
+	return ld
+}
+
+// eventDate returns the earliest start, or the latest end, in events as an ISO-8601 date
+// reduced to the precision it is known to, such as 1979 for a date only known to the year
+func eventDate(events []ChangeEvent, start bool) string {
+	var date, precision string
+	for _, e := range events {
+		d, p := e.EndedAt, e.EndedAtPrecision
+		if start {
+			d, p = e.StartedAt, e.StartedAtPrecision
+		}
+		if d == "" {
+			continue
+		}
+		if date == "" || (start && d < date) || (!start && d > date) {
+			date, precision = d, p
+		}
+	}
+
+	switch {
+	case precision == precisionYear && len(date) >= 4:
+		return date[:4]
+	case precision == precisionMonth && len(date) >= 7:
+		return date[:7]
+	}
+	return date
+}
@@ -0,0 +1,76 @@
+package people
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToJSONLD(t *testing.T) {
+	person := Person{
+		Thing:           Thing{ID: "http://api.ft.com/things/60e54253-1e94-38df-83b1-a39804d1ac18", PrefLabel: "Neil Cole"},
+		Labels:          []string{"Neil R. Cole"},
+		Salutation:      "Mr.",
+		BirthYear:       1957,
+		EmailAddress:    "example@example.com",
+		TwitterHandle:   "@ft",
+		FacebookProfile: "https://www.facebook.com/financialtimes/",
+		ImageURL:        "https://example.com/head.png",
+		Memberships: []Membership{
+			{
+				Title:        "Chairman and Chief Executive Officer",
+				Organisation: Organisation{Thing: Thing{ID: "http://api.ft.com/things/org", PrefLabel: "Iconix Brand Group Inc"}, LeiCode: "5493003WW5CLS8P3BF72"},
+				ChangeEvents: []ChangeEvent{{StartedAt: "1993-01-01", StartedAtPrecision: precisionYear}, {EndedAt: "2015-08-01", EndedAtPrecision: precisionMonth}},
+				Roles:        []Role{{Thing: Thing{PrefLabel: "Chairman"}}, {Thing: Thing{PrefLabel: "Chief Executive Officer"}}},
+			},
+			{
+				Title:        "Graduate Degree",
+				Organisation: Organisation{Thing: Thing{ID: "http://api.ft.com/things/uni", PrefLabel: "Hofstra University"}},
+			},
+		},
+	}
+
+	assert.Equal(t, jsonLDPerson{
+		Context:         "https://schema.org",
+		Type:            "Person",
+		ID:              "http://api.ft.com/things/60e54253-1e94-38df-83b1-a39804d1ac18",
+		Name:            "Neil Cole",
+		AlternateName:   []string{"Neil R. Cole"},
+		HonorificPrefix: "Mr.",
+		Image:           "https://example.com/head.png",
+		Email:           "example@example.com",
+		BirthDate:       "1957",
+		SameAs:          []string{"https://twitter.com/ft", "https://www.facebook.com/financialtimes/"},
+		WorksFor: []jsonLDRole{
+			{
+				Type:      "OrganizationRole",
+				Name:      "Chairman and Chief Executive Officer",
+				RoleName:  []string{"Chairman", "Chief Executive Officer"},
+				StartDate: "1993",
+				EndDate:   "2015-08",
+				WorksFor:  jsonLDOrganisation{Type: "Organization", ID: "http://api.ft.com/things/org", Name: "Iconix Brand Group Inc", LeiCode: "5493003WW5CLS8P3BF72"},
+			},
+			{
+				Type:     "OrganizationRole",
+				Name:     "Graduate Degree",
+				WorksFor: jsonLDOrganisation{Type: "Organization", ID: "http://api.ft.com/things/uni", Name: "Hofstra University"},
+			},
+		},
+	}, toJSONLD(person))
+}
+
+func TestEventDate(t *testing.T) {
+	events := []ChangeEvent{
+		{StartedAt: "2001-05-01", StartedAtPrecision: precisionMonth},
+		{EndedAt: "2003-02-01", EndedAtPrecision: precisionDay},
+		{StartedAt: "1999-01-01", StartedAtPrecision: precisionYear},
+		{EndedAt: "2010-01-01", EndedAtPrecision: precisionYear},
+	}
+	assert.Equal(t, "1999", eventDate(events, true))
+	assert.Equal(t, "2010", eventDate(events, false))
+	assert.Equal(t, "", eventDate(nil, true))
+
+	mixed := normaliseChangeEvents("http://www.ft.com/thing/uuid", []ChangeEvent{{StartedAt: "2001", EndedAt: "2003-05-06"}})
+	assert.Equal(t, "2001", eventDate(mixed, true))
+	assert.Equal(t, "2003-05-06", eventDate(mixed, false), "a day-precise end should not be cut to the precision of its start")
+}
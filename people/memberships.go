@@ -0,0 +1,254 @@
+package people
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	membershipsParam = "memberships"
+	asOfParam        = "asOf"
+	asOfLayout       = "2006-01-02"
+
+	membershipsCurrent = "current"
+	membershipsPast    = "past"
+	membershipsAll     = "all"
+	// membershipsFuture is the status of memberships yet to start, which are only kept by all
+	membershipsFuture = "future"
+)
+
+// membershipFilter selects the memberships, and their roles, that are current or past on a date
+type membershipFilter struct {
+	status string
+	asOf   time.Time
+}
+
+// parseMembershipFilter reads the memberships and asOf query parameters. It returns nil when
+// every membership should be kept, and the name of the offending parameter along with any error.
+func parseMembershipFilter(query url.Values, now time.Time) (*membershipFilter, string, error) {
+	f := membershipFilter{status: membershipsAll, asOf: now.UTC().Truncate(24 * time.Hour)}
+
+	if values, ok := query[membershipsParam]; ok {
+		if len(values) != 1 {
+			return nil, membershipsParam, fmt.Errorf("%s must be given once", membershipsParam)
+		}
+		switch values[0] {
+		case membershipsCurrent, membershipsPast, membershipsAll:
+			f.status = values[0]
+		default:
+			return nil, membershipsParam, fmt.Errorf("unknown value %q, expected one of %s, %s or %s", values[0], membershipsCurrent, membershipsPast, membershipsAll)
+		}
+	}
+
+	if values, ok := query[asOfParam]; ok {
+		if len(values) != 1 {
+			return nil, asOfParam, fmt.Errorf("%s must be given once", asOfParam)
+		}
+		asOf, err := time.Parse(asOfLayout, values[0])
+		if err != nil {
+			return nil, asOfParam, fmt.Errorf("%q is not a date of the form YYYY-MM-DD", values[0])
+		}
+		f.asOf = asOf
+	}
+
+	if f.status == membershipsAll {
+		return nil, "", nil
+	}
+	return &f, "", nil
+}
+
+// apply returns a copy of p holding only the memberships and roles selected by the filter
+func (f *membershipFilter) apply(p Person) Person {
+	if f == nil {
+		return p
+	}
+	p.Memberships = f.filter(p.Memberships)
+	return p
+}
+
+// filter returns the memberships selected by the filter, with only their selected roles.
+// Roles without any dates are kept for as long as their membership is.
+func (f *membershipFilter) filter(all []Membership) []Membership {
+	if f == nil {
+		return all
+	}
+
+	var memberships []Membership
+	for _, m := range all {
+		if periodStatus(m.ChangeEvents, f.asOf) != f.status {
+			continue
+		}
+		var roles []Role
+		for _, r := range m.Roles {
+			if len(r.ChangeEvents) == 0 || periodStatus(r.ChangeEvents, f.asOf) == f.status {
+				roles = append(roles, r)
+			}
+		}
+		m.Roles = roles
+		memberships = append(memberships, m)
+	}
+	return memberships
+}
+
+// periodStatus works out whether the period described by events is current, past or yet to start on date.
+// The latest event on or before date decides; a period with no dates at all is taken to be current.
+func periodStatus(events []ChangeEvent, date time.Time) string {
+	var lastStart, lastEnd time.Time
+	startsLater := false
+	for _, e := range events {
+		if start, ok := parseEventDate(e.StartedAt); ok {
+			if start.After(date) {
+				startsLater = true
+			} else if start.After(lastStart) {
+				lastStart = start
+			}
+		}
+		if end, ok := parseEventDate(e.EndedAt); ok && !end.After(date) && end.After(lastEnd) {
+			lastEnd = end
+		}
+	}
+
+	switch {
+	case !lastEnd.IsZero() && !lastEnd.Before(lastStart):
+		return membershipsPast
+	case lastStart.IsZero() && startsLater:
+		return membershipsFuture
+	}
+	return membershipsCurrent
+}
+
+const (
+	organisationParam = "organisation"
+	roleTypeParam     = "roleType"
+	sortParam         = "sort"
+
+	sortStartedAt = "startedAt"
+	sortEndedAt   = "endedAt"
+)
+
+// membershipQuery selects, and orders, memberships for the memberships sub-resource
+type membershipQuery struct {
+	organisation string
+	roleType     string
+	sortBy       string
+	descending   bool
+}
+
+// parseMembershipQuery reads the organisation, roleType and sort query parameters,
+// returning the name of the offending parameter along with any error
+func parseMembershipQuery(query url.Values, validUUID *regexp.Regexp) (membershipQuery, string, error) {
+	q := membershipQuery{
+		organisation: query.Get(organisationParam),
+		roleType:     query.Get(roleTypeParam),
+	}
+	if q.organisation != "" && !validUUID.MatchString(q.organisation) {
+		return q, organisationParam, fmt.Errorf("%q is not a UUID", q.organisation)
+	}
+
+	if sortBy := query.Get(sortParam); sortBy != "" {
+		q.descending = strings.HasPrefix(sortBy, "-")
+		q.sortBy = strings.TrimPrefix(sortBy, "-")
+		if q.sortBy != sortStartedAt && q.sortBy != sortEndedAt {
+			return q, sortParam, fmt.Errorf("unknown sort %q, expected %s or %s, optionally prefixed with - for descending order", sortBy, sortStartedAt, sortEndedAt)
+		}
+	}
+	return q, "", nil
+}
+
+// apply returns the memberships matching the query, in the order it asks for.
+// Memberships without the date being sorted on are always last.
+func (q membershipQuery) apply(memberships []Membership) []Membership {
+	var selected []Membership
+	for _, m := range memberships {
+		if q.organisation != "" && !strings.HasSuffix(m.Organisation.ID, q.organisation) {
+			continue
+		}
+		if q.roleType != "" && !hasRoleType(m.Roles, q.roleType) {
+			continue
+		}
+		selected = append(selected, m)
+	}
+
+	if q.sortBy == "" {
+		return selected
+	}
+	dateOf := membershipStart
+	if q.sortBy == sortEndedAt {
+		dateOf = membershipEnd
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		a, aOk := dateOf(selected[i].ChangeEvents)
+		b, bOk := dateOf(selected[j].ChangeEvents)
+		if !aOk || !bOk {
+			return aOk
+		}
+		if q.descending {
+			return a.After(b)
+		}
+		return a.Before(b)
+	})
+	return selected
+}
+
+// hasRoleType reports whether any of roles is of roleType, given either as
+// a full type URI or as its last segment, such as BoardRole
+func hasRoleType(roles []Role, roleType string) bool {
+	for _, r := range roles {
+		for _, t := range append([]string{r.DirectType}, r.Types...) {
+			if t == roleType || strings.HasSuffix(t, "/"+roleType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// membershipStart returns the earliest start date in events
+func membershipStart(events []ChangeEvent) (time.Time, bool) {
+	var start time.Time
+	found := false
+	for _, e := range events {
+		if t, ok := parseEventDate(e.StartedAt); ok && (!found || t.Before(start)) {
+			start, found = t, true
+		}
+	}
+	return start, found
+}
+
+// membershipEnd returns the latest end date in events
+func membershipEnd(events []ChangeEvent) (time.Time, bool) {
+	var end time.Time
+	found := false
+	for _, e := range events {
+		if t, ok := parseEventDate(e.EndedAt); ok && (!found || t.After(end)) {
+			end, found = t, true
+		}
+	}
+	return end, found
+}
+
+// sortOrganisationMembers orders the members of an organisation by the label of their
+// first role, and then chronologically by the start of their membership
+func sortOrganisationMembers(members []OrganisationMember) {
+	roleOf := func(m OrganisationMember) string {
+		if len(m.Membership.Roles) == 0 {
+			return ""
+		}
+		return m.Membership.Roles[0].PrefLabel
+	}
+	sort.SliceStable(members, func(i, j int) bool {
+		if a, b := roleOf(members[i]), roleOf(members[j]); a != b {
+			return a < b
+		}
+		a, aOk := membershipStart(members[i].Membership.ChangeEvents)
+		b, bOk := membershipStart(members[j].Membership.ChangeEvents)
+		if !aOk || !bOk {
+			return aOk && !bOk
+		}
+		return a.Before(b)
+	})
+}
@@ -0,0 +1,155 @@
+package people
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodStatus(t *testing.T) {
+	date := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		events   []ChangeEvent
+		expected string
+	}{
+		{"Undated", nil, membershipsCurrent},
+		{"Started", []ChangeEvent{{StartedAt: "2010-01-01"}}, membershipsCurrent},
+		{"StartedAndEnded", []ChangeEvent{{StartedAt: "1979-01-01"}, {EndedAt: "1982-01-01"}}, membershipsPast},
+		{"EndsLater", []ChangeEvent{{StartedAt: "2010-01-01"}, {EndedAt: "2020-01-01"}}, membershipsCurrent},
+		{"OnlyEndsLater", []ChangeEvent{{EndedAt: "2020-01-01"}}, membershipsCurrent},
+		{"OnlyEnded", []ChangeEvent{{EndedAt: "2001-01-01"}}, membershipsPast},
+		{"StartsLater", []ChangeEvent{{StartedAt: "2019-01-01"}}, membershipsFuture},
+		{"Restarted", []ChangeEvent{{StartedAt: "2001-01-01"}, {EndedAt: "2005-01-01"}, {StartedAt: "2010-01-01"}}, membershipsCurrent},
+		{"EndsOnDate", []ChangeEvent{{StartedAt: "2001-01-01", EndedAt: "2018-06-01"}}, membershipsPast},
+		{"YearPrecision", []ChangeEvent{{StartedAt: "2001-01-01", StartedAtPrecision: precisionYear}, {EndedAt: "2017-01-01", EndedAtPrecision: precisionYear}}, membershipsPast},
+		{"NotNormalised", []ChangeEvent{{StartedAt: "2001-01-01T00:00:00Z"}, {EndedAt: "2017"}}, membershipsCurrent},
+		{"Unparseable", []ChangeEvent{{StartedAt: "sometime"}, {EndedAt: "later"}}, membershipsCurrent},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, periodStatus(test.events, date), test.name)
+	}
+}
+
+func TestParseMembershipFilter(t *testing.T) {
+	now := time.Date(2018, 6, 1, 13, 45, 0, 0, time.UTC)
+
+	f, _, err := parseMembershipFilter(url.Values{}, now)
+	assert.NoError(t, err)
+	assert.Nil(t, f)
+
+	f, _, err = parseMembershipFilter(url.Values{"memberships": {"all"}, "asOf": {"2001-01-01"}}, now)
+	assert.NoError(t, err)
+	assert.Nil(t, f)
+
+	f, _, err = parseMembershipFilter(url.Values{"memberships": {"current"}}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, &membershipFilter{status: membershipsCurrent, asOf: time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)}, f)
+
+	f, _, err = parseMembershipFilter(url.Values{"memberships": {"past"}, "asOf": {"2001-02-03"}}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, &membershipFilter{status: membershipsPast, asOf: time.Date(2001, 2, 3, 0, 0, 0, 0, time.UTC)}, f)
+
+	_, param, err := parseMembershipFilter(url.Values{"memberships": {"future"}}, now)
+	assert.Error(t, err)
+	assert.Equal(t, membershipsParam, param)
+
+	_, param, err = parseMembershipFilter(url.Values{"memberships": {"current"}, "asOf": {"01/02/2003"}}, now)
+	assert.Error(t, err)
+	assert.Equal(t, asOfParam, param)
+}
+
+func TestMembershipFilter_Apply(t *testing.T) {
+	person := Person{
+		Memberships: []Membership{
+			{
+				Title:        "Chairman",
+				ChangeEvents: []ChangeEvent{{StartedAt: "2001-01-01"}},
+				Roles: []Role{
+					{Thing: Thing{PrefLabel: "Chairman"}, ChangeEvents: []ChangeEvent{{StartedAt: "2005-01-01"}}},
+					{Thing: Thing{PrefLabel: "Director"}, ChangeEvents: []ChangeEvent{{StartedAt: "2001-01-01"}, {EndedAt: "2005-01-01"}}},
+					{Thing: Thing{PrefLabel: "Member"}},
+				},
+			},
+			{
+				Title:        "Graduate Degree",
+				ChangeEvents: []ChangeEvent{{StartedAt: "1979-01-01"}, {EndedAt: "1982-01-01"}},
+				Roles:        []Role{{Thing: Thing{PrefLabel: "Graduate Degree"}}},
+			},
+		},
+	}
+	asOf := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	current := (&membershipFilter{status: membershipsCurrent, asOf: asOf}).apply(person)
+	assert.Len(t, current.Memberships, 1)
+	assert.Equal(t, "Chairman", current.Memberships[0].Title)
+	assert.Equal(t, []Role{person.Memberships[0].Roles[0], person.Memberships[0].Roles[2]}, current.Memberships[0].Roles)
+
+	past := (&membershipFilter{status: membershipsPast, asOf: asOf}).apply(person)
+	assert.Len(t, past.Memberships, 1)
+	assert.Equal(t, "Graduate Degree", past.Memberships[0].Title)
+
+	earlier := (&membershipFilter{status: membershipsCurrent, asOf: time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)}).apply(person)
+	assert.Len(t, earlier.Memberships, 1)
+	assert.Equal(t, "Graduate Degree", earlier.Memberships[0].Title)
+
+	assert.Len(t, person.Memberships, 2, "the original person should not be changed")
+	assert.Len(t, person.Memberships[0].Roles, 3, "the original person should not be changed")
+
+	var none *membershipFilter
+	assert.Equal(t, person, none.apply(person))
+}
+
+func TestMembershipQuery_Apply(t *testing.T) {
+	memberships := []Membership{
+		{
+			Title:        "Chairman",
+			Organisation: Organisation{Thing: Thing{ID: "http://api.ft.com/things/1d448227-8b1b-3490-aeb8-18aa699d75f8"}},
+			ChangeEvents: []ChangeEvent{{StartedAt: "2005-01-01"}},
+			Roles:        []Role{{DirectType: "http://www.ft.com/ontology/organisation/BoardRole"}},
+		},
+		{
+			Title:        "Graduate Degree",
+			Organisation: Organisation{Thing: Thing{ID: "http://api.ft.com/things/2d3e16e0-61cb-4322-8aff-3b01c59f4daa"}},
+			ChangeEvents: []ChangeEvent{{StartedAt: "1979-01-01"}, {EndedAt: "1982-01-01"}},
+			Roles:        []Role{{DirectType: "http://www.ft.com/ontology/MembershipRole"}},
+		},
+		{
+			Title:        "Adviser",
+			Organisation: Organisation{Thing: Thing{ID: "http://api.ft.com/things/1d448227-8b1b-3490-aeb8-18aa699d75f8"}},
+			Roles:        []Role{{DirectType: "http://www.ft.com/ontology/MembershipRole"}},
+		},
+		{
+			Title:        "Director",
+			Organisation: Organisation{Thing: Thing{ID: "http://api.ft.com/things/60e54253-1e94-38df-83b1-a39804d1ac18"}},
+			ChangeEvents: []ChangeEvent{{StartedAt: "1990-01-01"}, {EndedAt: "2001-01-01"}},
+			Roles:        []Role{{DirectType: "http://www.ft.com/ontology/organisation/BoardRole"}},
+		},
+	}
+	titles := func(ms []Membership) []string {
+		var t []string
+		for _, m := range ms {
+			t = append(t, m.Title)
+		}
+		return t
+	}
+
+	tests := []struct {
+		query    membershipQuery
+		expected []string
+	}{
+		{membershipQuery{}, []string{"Chairman", "Graduate Degree", "Adviser", "Director"}},
+		{membershipQuery{sortBy: sortStartedAt}, []string{"Graduate Degree", "Director", "Chairman", "Adviser"}},
+		{membershipQuery{sortBy: sortStartedAt, descending: true}, []string{"Chairman", "Director", "Graduate Degree", "Adviser"}},
+		{membershipQuery{sortBy: sortEndedAt}, []string{"Graduate Degree", "Director", "Chairman", "Adviser"}},
+		{membershipQuery{organisation: "1d448227-8b1b-3490-aeb8-18aa699d75f8"}, []string{"Chairman", "Adviser"}},
+		{membershipQuery{roleType: "BoardRole"}, []string{"Chairman", "Director"}},
+		{membershipQuery{roleType: "http://www.ft.com/ontology/MembershipRole", sortBy: sortStartedAt}, []string{"Graduate Degree", "Adviser"}},
+		{membershipQuery{roleType: "Role"}, nil},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, titles(test.query.apply(memberships)), "%+v", test.query)
+	}
+}
@@ -21,8 +21,127 @@ type Person struct {
 	FacebookProfile string       `json:"facebookProfile,omitempty"`
 	Description     string       `json:"description,omitempty"`
 	DescriptionXML  string       `json:"descriptionXML,omitempty"`
-	ImageURL        string       `json:"_imageUrl,omitempty"` // TODO we should implement this properly as an imageset
+	ImageURL        string       `json:"_imageUrl,omitempty"` // kept for older clients, see ImageSet
 	IsDeprecated    bool         `json:"isDeprecated,omitempty"`
+	// RequestedUUID is the concorded UUID the person was requested by, when it is served in place of a redirect
+	RequestedUUID string `json:"requestedUUID,omitempty"`
+	// AlternativeLabels are the labels along with their type, such as alias or formerName
+	AlternativeLabels []AlternativeLabel `json:"alternativeLabels,omitempty"`
+	// Accounts are every account of the person, including those also served as emailAddress, twitterHandle and facebookProfile
+	Accounts []Account `json:"accounts,omitempty"`
+	// ImageSet holds renditions of the image of the person, built from ImageURL by the image service
+	ImageSet *ImageSet `json:"imageSet,omitempty"`
+	// DescriptionHTML and DescriptionText are rendered from DescriptionXML, in its place, when asked for
+	DescriptionHTML string `json:"descriptionHTML,omitempty"`
+	DescriptionText string `json:"descriptionText,omitempty"`
+}
+
+// ImageSet is the renditions of an image, each of them sized for a different layout
+type ImageSet struct {
+	Renditions []ImageRendition `json:"renditions"`
+}
+
+// ImageRendition is an image at a given size and format
+type ImageRendition struct {
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Format string `json:"format"`
+	URL    string `json:"url"`
+}
+
+// AlternativeLabel is a label of a person and the kind of name it is
+type AlternativeLabel struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value"`
+}
+
+// Account is an online presence of a person, such as a LinkedIn profile or personal website
+type Account struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value"`
+}
+
+// People is the response of a batch lookup, keyed by the requested UUID
+type People struct {
+	People map[string]PersonResult `json:"people"`
+}
+
+// PersonResult is the outcome of looking up a single person in a batch
+type PersonResult struct {
+	Status   int     `json:"status"`
+	Code     string  `json:"code,omitempty"`
+	Message  string  `json:"message,omitempty"`
+	Location string  `json:"location,omitempty"`
+	Person   *Person `json:"person,omitempty"`
+}
+
+// Memberships is a page of the memberships of a person
+type Memberships struct {
+	Memberships []Membership `json:"memberships"`
+	Total       int          `json:"total"`
+	Links       PageLinks    `json:"links"`
+}
+
+// Timeline is the career of a person as a chronological list of the starts and ends of
+// their memberships and roles
+type Timeline struct {
+	Events  []TimelineEvent `json:"events"`
+	Undated []TimelineEvent `json:"undated"`
+}
+
+// TimelineEvent is the start or end of a membership, or of a role within it
+type TimelineEvent struct {
+	Date         string `json:"date,omitempty"`
+	Precision    string `json:"precision,omitempty"`
+	Event        string `json:"event,omitempty"`
+	Kind         string `json:"kind"`
+	Title        string `json:"title,omitempty"`
+	Organisation Thing  `json:"organisation"`
+	Role         *Thing `json:"role,omitempty"`
+	// Ongoing is set on the latest start of a membership or role that has not ended since
+	Ongoing bool `json:"ongoing,omitempty"`
+}
+
+// OrganisationPeople is a page of the people holding memberships of an organisation
+type OrganisationPeople struct {
+	People []OrganisationMember `json:"people"`
+	Total  int                  `json:"total"`
+	Links  PageLinks            `json:"links"`
+}
+
+// OrganisationMember is a person and their membership of an organisation
+type OrganisationMember struct {
+	Person     Thing      `json:"person"`
+	Membership Membership `json:"membership"`
+}
+
+// SearchResults is a page of the people matching a search, best matches first
+type SearchResults struct {
+	People []Thing   `json:"people"`
+	Total  int       `json:"total"`
+	Links  PageLinks `json:"links"`
+}
+
+// PageLinks link a page of results to its neighbours
+type PageLinks struct {
+	Self string `json:"self"`
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+}
+
+// ErrorResponse is the body of every error response
+type ErrorResponse struct {
+	Code          string        `json:"code"`
+	Message       string        `json:"message"`
+	TransactionID string        `json:"transactionId,omitempty"`
+	Details       *ErrorDetails `json:"details,omitempty"`
+}
+
+// ErrorDetails identifies the person an error relates to
+type ErrorDetails struct {
+	UUID          string `json:"uuid,omitempty"`
+	CanonicalUUID string `json:"canonicalUUID,omitempty"`
+	Parameter     string `json:"parameter,omitempty"`
 }
 
 // Membership represents the relationship between a person and their roles associated with an organisation
@@ -40,7 +159,13 @@ type Organisation struct {
 	Thing
 	Types      []string `json:"types"`
 	DirectType string   `json:"directType,omitempty"`
-	Labels     []string `json:"labels,omitempty"`
+	// The remaining fields are only served when the organisation is expanded
+	Labels                 []string `json:"labels,omitempty"`
+	CountryCode            string   `json:"countryCode,omitempty"`
+	CountryOfIncorporation string   `json:"countryOfIncorporation,omitempty"`
+	LeiCode                string   `json:"leiCode,omitempty"`
+	PostalCode             string   `json:"postalCode,omitempty"`
+	YearFounded            int      `json:"yearFounded,omitempty"`
 }
 
 // Role represents the capacity or funciton that a person performs for an organisation
@@ -55,6 +180,9 @@ type Role struct {
 type ChangeEvent struct {
 	StartedAt string `json:"startedAt,omitempty"`
 	EndedAt   string `json:"endedAt,omitempty"`
+	// StartedAtPrecision and EndedAtPrecision are whether each date is known to the year, month or day
+	StartedAtPrecision string `json:"startedAtPrecision,omitempty"`
+	EndedAtPrecision   string `json:"endedAtPrecision,omitempty"`
 }
 
 type TypedValue struct {
@@ -0,0 +1,79 @@
+package people
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	mediaTypeJSON   = "application/json"
+	mediaTypeJSONLD = "application/ld+json"
+)
+
+// personMediaTypes are the representations a person can be served in, the first being the default
+var personMediaTypes = []string{mediaTypeJSON, mediaTypeJSONLD, mediaTypeVCard, mediaTypeTurtle, mediaTypeNTriples}
+
+// negotiate picks the media type of offers most preferred by an Accept header. Each offer takes the
+// quality of the most specific media range matching it, and ties go to the earlier offer.
+// An empty header accepts anything. It returns false if none of offers is acceptable.
+func negotiate(accept string, offers []string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return offers[0], true
+	}
+
+	ranges := parseAccept(accept)
+	best, bestQuality := "", 0.0
+	for _, offer := range offers {
+		quality, specificity := 0.0, -1
+		for _, r := range ranges {
+			if s := r.matches(offer); s > specificity {
+				quality, specificity = r.quality, s
+			}
+		}
+		if quality > bestQuality {
+			best, bestQuality = offer, quality
+		}
+	}
+	return best, bestQuality > 0
+}
+
+type mediaRange struct {
+	mediaType string
+	quality   float64
+}
+
+func parseAccept(accept string) []mediaRange {
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		r := mediaRange{mediaType: strings.ToLower(strings.TrimSpace(params[0])), quality: 1}
+		if r.mediaType == "" {
+			continue
+		}
+		for _, param := range params[1:] {
+			name, value, _ := strings.Cut(param, "=")
+			if strings.TrimSpace(name) != "q" {
+				continue
+			}
+			if q, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+				r.quality = q
+			}
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
+
+// matches returns how specifically the range matches mediaType: 2 for the exact type,
+// 1 for type/* and 0 for */*, or -1 if it does not match at all
+func (r mediaRange) matches(mediaType string) int {
+	switch {
+	case r.mediaType == mediaType:
+		return 2
+	case r.mediaType == "*/*":
+		return 0
+	case strings.HasSuffix(r.mediaType, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(r.mediaType, "*")):
+		return 1
+	}
+	return -1
+}
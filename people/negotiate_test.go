@@ -0,0 +1,37 @@
+package people
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	offers := []string{mediaTypeJSON, mediaTypeJSONLD}
+	tests := []struct {
+		accept   string
+		expected string
+	}{
+		{"", mediaTypeJSON},
+		{"*/*", mediaTypeJSON},
+		{"application/*", mediaTypeJSON},
+		{"application/json", mediaTypeJSON},
+		{"application/json; charset=UTF-8", mediaTypeJSON},
+		{"application/ld+json", mediaTypeJSONLD},
+		{"Application/LD+JSON", mediaTypeJSONLD},
+		{"text/html, application/ld+json;q=0.9, */*;q=0.1", mediaTypeJSONLD},
+		{"application/json;q=0.5, application/ld+json", mediaTypeJSONLD},
+		{"application/*;q=0.2, application/ld+json;q=0.8", mediaTypeJSONLD},
+		{"application/json;q=0, */*", mediaTypeJSONLD},
+	}
+	for _, test := range tests {
+		mediaType, ok := negotiate(test.accept, offers)
+		assert.True(t, ok, test.accept)
+		assert.Equal(t, test.expected, mediaType, test.accept)
+	}
+
+	for _, accept := range []string{"text/html", "image/*", "application/json;q=0, application/ld+json;q=0"} {
+		_, ok := negotiate(accept, offers)
+		assert.False(t, ok, accept)
+	}
+}
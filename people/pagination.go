@@ -0,0 +1,102 @@
+package people
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	limitParam   = "limit"
+	cursorParam  = "cursor"
+	defaultLimit = 20
+	maxLimit     = 100
+
+	cursorPrefix = "offset:"
+)
+
+// page is the window of results requested with the limit and cursor query parameters
+type page struct {
+	offset int
+	limit  int
+}
+
+// parsePage reads the limit and cursor query parameters, returning the name of the
+// offending parameter along with any error
+func parsePage(query url.Values) (page, string, error) {
+	p := page{limit: defaultLimit}
+
+	if value := query.Get(limitParam); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxLimit {
+			return p, limitParam, fmt.Errorf("%s must be a number from 1 to %d", limitParam, maxLimit)
+		}
+		p.limit = limit
+	}
+
+	if value := query.Get(cursorParam); value != "" {
+		offset, err := decodeCursor(value)
+		if err != nil {
+			return p, cursorParam, err
+		}
+		p.offset = offset
+	}
+	return p, "", nil
+}
+
+// bounds returns the slice indexes of the page within total results
+func (p page) bounds(total int) (int, int) {
+	start := p.offset
+	if start > total {
+		start = total
+	}
+	end := start + p.limit
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+// links returns the links to the page, and to its neighbours, for the request URL u
+func (p page) links(u *url.URL, total int) PageLinks {
+	links := PageLinks{Self: p.link(u, p.offset)}
+	if p.offset+p.limit < total {
+		links.Next = p.link(u, p.offset+p.limit)
+	}
+	if p.offset > 0 {
+		prev := p.offset - p.limit
+		if prev < 0 {
+			prev = 0
+		}
+		links.Prev = p.link(u, prev)
+	}
+	return links
+}
+
+func (p page) link(u *url.URL, offset int) string {
+	query := u.Query()
+	query.Del(cursorParam)
+	if offset > 0 {
+		query.Set(cursorParam, encodeCursor(offset))
+	}
+	link := url.URL{Path: u.Path, RawQuery: query.Encode()}
+	return link.String()
+}
+
+// Cursors are opaque to clients so that how they are made can change
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err == nil && strings.HasPrefix(string(decoded), cursorPrefix) {
+		offset, err := strconv.Atoi(strings.TrimPrefix(string(decoded), cursorPrefix))
+		if err == nil && offset >= 0 {
+			return offset, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid %s", cursorParam)
+}
@@ -0,0 +1,65 @@
+package people
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor(t *testing.T) {
+	for _, offset := range []int{0, 1, 20, 12345} {
+		decoded, err := decodeCursor(encodeCursor(offset))
+		assert.NoError(t, err)
+		assert.Equal(t, offset, decoded)
+	}
+
+	for _, cursor := range []string{"20", "!!!", encodeCursor(-1)[:4], "b2Zmc2V0Oi0x"} {
+		_, err := decodeCursor(cursor)
+		assert.Error(t, err, cursor)
+	}
+}
+
+func TestParsePage(t *testing.T) {
+	p, _, err := parsePage(url.Values{})
+	assert.NoError(t, err)
+	assert.Equal(t, page{offset: 0, limit: defaultLimit}, p)
+
+	p, _, err = parsePage(url.Values{"limit": {"5"}, "cursor": {encodeCursor(10)}})
+	assert.NoError(t, err)
+	assert.Equal(t, page{offset: 10, limit: 5}, p)
+
+	for _, limit := range []string{"0", "101", "ten"} {
+		_, param, err := parsePage(url.Values{"limit": {limit}})
+		assert.Error(t, err, limit)
+		assert.Equal(t, limitParam, param)
+	}
+
+	_, param, err := parsePage(url.Values{"cursor": {"nonsense"}})
+	assert.Error(t, err)
+	assert.Equal(t, cursorParam, param)
+}
+
+func TestPageLinks(t *testing.T) {
+	u, _ := url.Parse("http://localhost/people/abc/memberships?limit=2&sort=startedAt")
+
+	first := page{offset: 0, limit: 2}
+	assert.Equal(t, PageLinks{
+		Self: "/people/abc/memberships?limit=2&sort=startedAt",
+		Next: "/people/abc/memberships?cursor=" + encodeCursor(2) + "&limit=2&sort=startedAt",
+	}, first.links(u, 5))
+
+	last := page{offset: 4, limit: 2}
+	assert.Equal(t, PageLinks{
+		Self: "/people/abc/memberships?cursor=" + encodeCursor(4) + "&limit=2&sort=startedAt",
+		Prev: "/people/abc/memberships?cursor=" + encodeCursor(2) + "&limit=2&sort=startedAt",
+	}, last.links(u, 5))
+
+	start, end := last.bounds(5)
+	assert.Equal(t, 4, start)
+	assert.Equal(t, 5, end)
+
+	start, end = page{offset: 10, limit: 2}.bounds(5)
+	assert.Equal(t, 5, start)
+	assert.Equal(t, 5, end)
+}
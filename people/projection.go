@@ -0,0 +1,121 @@
+package people
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const fieldsParam = "fields"
+
+// fieldSet is a projection of a JSON document. Each key is a field to keep;
+// a nil value keeps the whole field, otherwise only the nested fields it lists.
+type fieldSet map[string]fieldSet
+
+// parseFields parses a comma separated list of dotted field paths, such as
+// prefLabel,memberships.organisation.prefLabel, checking each against the JSON
+// representation of model
+func parseFields(param string, model reflect.Type) (fieldSet, error) {
+	fields := fieldSet{}
+	for _, path := range strings.Split(param, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			return nil, fmt.Errorf("empty field in %q", param)
+		}
+		if err := fields.add(strings.Split(path, "."), model, path); err != nil {
+			return nil, err
+		}
+	}
+	return fields, nil
+}
+
+func (f fieldSet) add(names []string, t reflect.Type, path string) error {
+	fieldType, ok := jsonFields(t)[names[0]]
+	if !ok {
+		return fmt.Errorf("unknown field %s", path)
+	}
+
+	if len(names) == 1 {
+		f[names[0]] = nil
+		return nil
+	}
+
+	nested, requested := f[names[0]]
+	if requested && nested == nil {
+		// the whole field is already requested, but the rest of the path must still exist
+		return fieldSet{}.add(names[1:], fieldType, path)
+	}
+	if nested == nil {
+		nested = fieldSet{}
+		f[names[0]] = nested
+	}
+	return nested.add(names[1:], fieldType, path)
+}
+
+// jsonFields returns the types of the fields in the JSON representation of t, keyed by name.
+// Slices and pointers are looked through, so that the fields of their elements can be projected.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	fields := map[string]reflect.Type{}
+	if t.Kind() != reflect.Struct {
+		return fields
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			for name, fieldType := range jsonFields(field.Type) {
+				fields[name] = fieldType
+			}
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" || field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// project returns the JSON representation of v reduced to the given fields
+func project(v interface{}, fields fieldSet) (interface{}, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(encoded, &doc); err != nil {
+		return nil, err
+	}
+	return fields.apply(doc), nil
+}
+
+func (f fieldSet) apply(doc interface{}) interface{} {
+	if f == nil {
+		return doc
+	}
+	switch doc := doc.(type) {
+	case map[string]interface{}:
+		projected := make(map[string]interface{}, len(f))
+		for name, nested := range f {
+			if value, ok := doc[name]; ok {
+				projected[name] = nested.apply(value)
+			}
+		}
+		return projected
+	case []interface{}:
+		projected := make([]interface{}, len(doc))
+		for i, element := range doc {
+			projected[i] = f.apply(element)
+		}
+		return projected
+	}
+	return doc
+}
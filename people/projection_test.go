@@ -0,0 +1,62 @@
+package people
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFields(t *testing.T) {
+	personType := reflect.TypeOf(Person{})
+	tests := []struct {
+		param    string
+		expected fieldSet
+		err      string
+	}{
+		{"prefLabel", fieldSet{"prefLabel": nil}, ""},
+		{"id,types", fieldSet{"id": nil, "types": nil}, ""},
+		{"prefLabel, _imageUrl", fieldSet{"prefLabel": nil, "_imageUrl": nil}, ""},
+		{"memberships.organisation.prefLabel,memberships.title", fieldSet{"memberships": fieldSet{"organisation": fieldSet{"prefLabel": nil}, "title": nil}}, ""},
+		{"memberships.title,memberships", fieldSet{"memberships": nil}, ""},
+		{"memberships,memberships.title", fieldSet{"memberships": nil}, ""},
+		{"memberships.roles.changeEvents.startedAt", fieldSet{"memberships": fieldSet{"roles": fieldSet{"changeEvents": fieldSet{"startedAt": nil}}}}, ""},
+		{"PrefLabel", nil, "unknown field PrefLabel"},
+		{"prefLabel.value", nil, "unknown field prefLabel.value"},
+		{"memberships.organisation.unknown", nil, "unknown field memberships.organisation.unknown"},
+		{"memberships,memberships.bogus", nil, "unknown field memberships.bogus"},
+		{"memberships.bogus,memberships", nil, "unknown field memberships.bogus"},
+		{"prefLabel,", nil, `empty field in "prefLabel,"`},
+		{"", nil, `empty field in ""`},
+	}
+
+	for _, test := range tests {
+		fields, err := parseFields(test.param, personType)
+		if test.err != "" {
+			assert.EqualError(t, err, test.err, test.param)
+			continue
+		}
+		assert.NoError(t, err, test.param)
+		assert.Equal(t, test.expected, fields, test.param)
+	}
+}
+
+func TestProject(t *testing.T) {
+	person := getExpectedPerson("60e54253-1e94-38df-83b1-a39804d1ac18", false)
+	fields, err := parseFields("prefLabel,memberships.organisation.prefLabel,birthYear,isDeprecated", reflect.TypeOf(Person{}))
+	assert.NoError(t, err)
+
+	projected, err := project(person, fields)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"prefLabel": "Neil Cole",
+		"birthYear": float64(1957),
+		"memberships": []interface{}{
+			map[string]interface{}{
+				"organisation": map[string]interface{}{
+					"prefLabel": "Maurice A. Deane School of Law at Hofstra University",
+				},
+			},
+		},
+	}, projected)
+}
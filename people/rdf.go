@@ -0,0 +1,235 @@
+package people
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	mediaTypeTurtle   = "text/turtle"
+	mediaTypeNTriples = "application/n-triples"
+
+	ftOntology = "http://www.ft.com/ontology/"
+	rdfNS      = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	skosNS     = "http://www.w3.org/2004/02/skos/core#"
+	xsdNS      = "http://www.w3.org/2001/XMLSchema#"
+)
+
+// rdfPrefixes are the namespaces abbreviated in Turtle, in the order they are declared
+var rdfPrefixes = []struct{ prefix, namespace string }{
+	{"ft", ftOntology},
+	{"things", thingsApiUrl},
+	{"rdf", rdfNS},
+	{"skos", skosNS},
+	{"xsd", xsdNS},
+}
+
+var (
+	rdfType       = iri(rdfNS + "type")
+	skosPrefLabel = iri(skosNS + "prefLabel")
+	skosAltLabel  = iri(skosNS + "altLabel")
+
+	// turtleLocalName matches the local names that are safe to abbreviate with a prefix
+	turtleLocalName = regexp.MustCompile(`^[A-Za-z0-9_]([A-Za-z0-9_-]*[A-Za-z0-9_])?$`)
+	iriEscaper      = strings.NewReplacer(" ", "%20", "<", "%3C", ">", "%3E", `"`, "%22", "{", "%7B", "}", "%7D", "|", "%7C", "^", "%5E", "`", "%60", `\`, "%5C")
+	literalEscaper  = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+)
+
+type termKind int
+
+const (
+	termIRI termKind = iota
+	termBlank
+	termLiteral
+)
+
+// term is a node or predicate of an RDF graph
+type term struct {
+	kind     termKind
+	value    string
+	datatype string
+}
+
+func iri(s string) term       { return term{kind: termIRI, value: s} }
+func blank(s string) term     { return term{kind: termBlank, value: s} }
+func literal(s string) term   { return term{kind: termLiteral, value: s} }
+func typed(s, dt string) term { return term{kind: termLiteral, value: s, datatype: xsdNS + dt} }
+func ft(property string) term { return iri(ftOntology + property) }
+
+type triple struct {
+	subject, predicate, object term
+}
+
+// graph is a list of triples, kept grouped by subject
+type graph []triple
+
+func (g *graph) add(s, p, o term) {
+	*g = append(*g, triple{s, p, o})
+}
+
+func (g *graph) addLiteral(s, p term, value string) {
+	if value != "" {
+		g.add(s, p, literal(value))
+	}
+}
+
+// personGraph describes p using the FT ontology. Memberships have no identifier of their own
+// so are blank nodes, linked to the person, organisation and roles as in the concepts API.
+func personGraph(p Person) graph {
+	var g graph
+	person := iri(p.ID)
+	for _, t := range p.Types {
+		g.add(person, rdfType, iri(t))
+	}
+	g.addLiteral(person, skosPrefLabel, p.PrefLabel)
+	for _, label := range p.Labels {
+		g.addLiteral(person, skosAltLabel, label)
+	}
+	g.addLiteral(person, ft("salutation"), p.Salutation)
+	if p.BirthYear > 0 {
+		g.add(person, ft("birthYear"), typed(strconv.Itoa(p.BirthYear), "gYear"))
+	}
+	g.addLiteral(person, ft("emailAddress"), p.EmailAddress)
+	g.addLiteral(person, ft("twitterHandle"), p.TwitterHandle)
+	g.addLiteral(person, ft("facebookProfile"), p.FacebookProfile)
+	g.addLiteral(person, ft("description"), p.Description)
+	if p.ImageURL != "" {
+		g.add(person, ft("imageURL"), iri(p.ImageURL))
+	}
+
+	memberships := make([]term, len(p.Memberships))
+	for i := range p.Memberships {
+		memberships[i] = blank(fmt.Sprintf("membership%d", i+1))
+		g.add(person, ft("membership"), memberships[i])
+	}
+
+	described := map[string]bool{}
+	var related graph
+	for i, m := range p.Memberships {
+		membership := memberships[i]
+		for _, t := range m.Types {
+			g.add(membership, rdfType, iri(t))
+		}
+		g.addLiteral(membership, skosPrefLabel, m.Title)
+		g.add(membership, ft("membershipPerson"), person)
+		if start := eventDate(m.ChangeEvents, true); start != "" {
+			g.add(membership, ft("inceptionDate"), rdfDate(start))
+		}
+		if end := eventDate(m.ChangeEvents, false); end != "" {
+			g.add(membership, ft("terminationDate"), rdfDate(end))
+		}
+
+		if m.Organisation.ID != "" {
+			g.add(membership, ft("membershipOrganisation"), iri(m.Organisation.ID))
+			related.describe(described, m.Organisation.Thing, m.Organisation.Types)
+		}
+		for _, r := range m.Roles {
+			if r.ID == "" {
+				continue
+			}
+			g.add(membership, ft("membershipRole"), iri(r.ID))
+			related.describe(described, r.Thing, r.Types)
+		}
+	}
+	return append(g, related...)
+}
+
+// describe adds the types and label of a thing, once however often it is related to
+func (g *graph) describe(described map[string]bool, t Thing, types []string) {
+	if described[t.ID] {
+		return
+	}
+	described[t.ID] = true
+	for _, typ := range types {
+		g.add(iri(t.ID), rdfType, iri(typ))
+	}
+	g.addLiteral(iri(t.ID), skosPrefLabel, t.PrefLabel)
+}
+
+// rdfDate types an ISO-8601 date according to the precision it is given to
+func rdfDate(date string) term {
+	switch len(date) {
+	case 4:
+		return typed(date, "gYear")
+	case 7:
+		return typed(date, "gYearMonth")
+	}
+	return typed(date, "date")
+}
+
+// nTriples writes the graph as N-Triples
+func (g graph) nTriples() []byte {
+	var buf bytes.Buffer
+	for _, t := range g {
+		fmt.Fprintf(&buf, "%s %s %s .\n", t.subject.nTriple(), t.predicate.nTriple(), t.object.nTriple())
+	}
+	return buf.Bytes()
+}
+
+func (t term) nTriple() string {
+	switch t.kind {
+	case termBlank:
+		return "_:" + t.value
+	case termLiteral:
+		s := `"` + literalEscaper.Replace(t.value) + `"`
+		if t.datatype != "" {
+			s += "^^" + iri(t.datatype).nTriple()
+		}
+		return s
+	}
+	return "<" + iriEscaper.Replace(t.value) + ">"
+}
+
+// turtle writes the graph as Turtle, abbreviating the FT ontology and other well known namespaces
+// and grouping the triples of each subject
+func (g graph) turtle() []byte {
+	var buf bytes.Buffer
+	for _, p := range rdfPrefixes {
+		fmt.Fprintf(&buf, "@prefix %s: <%s> .\n", p.prefix, p.namespace)
+	}
+
+	for i, t := range g {
+		switch {
+		case i > 0 && t.subject == g[i-1].subject && t.predicate == g[i-1].predicate:
+			buf.WriteString(" ,\n        ")
+		case i > 0 && t.subject == g[i-1].subject:
+			buf.WriteString(" ;\n    " + t.predicate.turtlePredicate() + " ")
+		default:
+			if i > 0 {
+				buf.WriteString(" .\n")
+			}
+			buf.WriteString("\n" + t.subject.turtle() + "\n    " + t.predicate.turtlePredicate() + " ")
+		}
+		buf.WriteString(t.object.turtle())
+	}
+	if len(g) > 0 {
+		buf.WriteString(" .\n")
+	}
+	return buf.Bytes()
+}
+
+func (t term) turtlePredicate() string {
+	if t == rdfType {
+		return "a"
+	}
+	return t.turtle()
+}
+
+func (t term) turtle() string {
+	switch t.kind {
+	case termIRI:
+		for _, p := range rdfPrefixes {
+			if local := strings.TrimPrefix(t.value, p.namespace); local != t.value && turtleLocalName.MatchString(local) {
+				return p.prefix + ":" + local
+			}
+		}
+	case termLiteral:
+		if t.datatype != "" {
+			return `"` + literalEscaper.Replace(t.value) + `"^^` + iri(t.datatype).turtle()
+		}
+	}
+	return t.nTriple()
+}
@@ -0,0 +1,85 @@
+package people
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rdfTestPerson() Person {
+	iconix := Organisation{
+		Thing: Thing{ID: "http://api.ft.com/things/1d448227-8b1b-3490-aeb8-18aa699d75f8", PrefLabel: "Iconix Brand Group Inc"},
+		Types: []string{"http://www.ft.com/ontology/core/Thing", "http://www.ft.com/ontology/organisation/Organisation"},
+	}
+	return Person{
+		Thing:         Thing{ID: "http://api.ft.com/things/60e54253-1e94-38df-83b1-a39804d1ac18", PrefLabel: "Neil Cole"},
+		Types:         []string{"http://www.ft.com/ontology/core/Thing", "http://www.ft.com/ontology/concept/Concept", "http://www.ft.com/ontology/person/Person"},
+		Labels:        []string{"Neil \"The Brand\" Cole", "Neil R. Cole"},
+		Salutation:    "Mr.",
+		BirthYear:     1957,
+		TwitterHandle: "@ft",
+		Description:   "Founder of Iconix\nand brand investor",
+		ImageURL:      "https://example.com/images/neil cole.png",
+		Memberships: []Membership{
+			{
+				Title:        "Chairman",
+				Types:        []string{"http://www.ft.com/ontology/organisation/Membership"},
+				Organisation: iconix,
+				ChangeEvents: []ChangeEvent{{StartedAt: "1993-01-01", StartedAtPrecision: precisionYear}, {EndedAt: "2015-08-01", EndedAtPrecision: precisionMonth}},
+				Roles: []Role{{
+					Thing: Thing{ID: "http://api.ft.com/things/5a3e354e-13dc-3287-8950-230f3c6416d1", PrefLabel: "Chairman"},
+					Types: []string{"http://www.ft.com/ontology/organisation/BoardRole"},
+				}},
+			},
+			{
+				Title:        "Director",
+				Types:        []string{"http://www.ft.com/ontology/organisation/Membership"},
+				Organisation: iconix,
+				ChangeEvents: []ChangeEvent{{StartedAt: "2016-03-14", StartedAtPrecision: precisionDay}},
+				Roles:        []Role{{Thing: Thing{PrefLabel: "Unidentified role"}}},
+			},
+		},
+	}
+}
+
+func TestPersonGraph(t *testing.T) {
+	g := personGraph(rdfTestPerson())
+
+	for name, actual := range map[string][]byte{"person.ttl": g.turtle(), "person.nt": g.nTriples()} {
+		golden := filepath.Join("testdata", "rdf", name)
+		if *updateGolden {
+			assert.NoError(t, os.WriteFile(golden, actual, 0644), name)
+		}
+
+		expected, err := os.ReadFile(golden)
+		assert.NoError(t, err, name)
+		assert.Equal(t, string(expected), string(actual), name)
+	}
+
+	subjects := map[term]int{}
+	for _, tr := range g {
+		subjects[tr.subject]++
+	}
+	assert.Len(t, subjects, 5, "the person, two memberships, the organisation and the role")
+}
+
+func TestGraph_Empty(t *testing.T) {
+	var g graph
+	assert.Empty(t, g.nTriples())
+	assert.Equal(t, "@prefix ft: <http://www.ft.com/ontology/> .\n"+
+		"@prefix things: <http://api.ft.com/things/> .\n"+
+		"@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .\n"+
+		"@prefix skos: <http://www.w3.org/2004/02/skos/core#> .\n"+
+		"@prefix xsd: <http://www.w3.org/2001/XMLSchema#> .\n", string(g.turtle()))
+}
+
+func TestTerm_Turtle(t *testing.T) {
+	assert.Equal(t, "ft:membership", ft("membership").turtle())
+	assert.Equal(t, "<http://www.ft.com/ontology/person/Person>", iri("http://www.ft.com/ontology/person/Person").turtle())
+	assert.Equal(t, "things:60e54253-1e94-38df-83b1-a39804d1ac18", iri("http://api.ft.com/things/60e54253-1e94-38df-83b1-a39804d1ac18").turtle())
+	assert.Equal(t, `"1957"^^xsd:gYear`, typed("1957", "gYear").turtle())
+	assert.Equal(t, `"a \"b\"\n\\c"`, literal("a \"b\"\n\\c").turtle())
+	assert.Equal(t, "_:membership1", blank("membership1").turtle())
+}
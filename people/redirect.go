@@ -0,0 +1,50 @@
+package people
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// RedirectMode is how a person requested by a concorded, non-canonical, UUID is served
+type RedirectMode string
+
+const (
+	// RedirectMovedPermanently answers with a 301 to the canonical person
+	RedirectMovedPermanently RedirectMode = "301"
+	// RedirectPermanent answers with a 308 to the canonical person
+	RedirectPermanent RedirectMode = "308"
+	// RedirectInline serves the canonical person directly, with a Content-Location header
+	RedirectInline RedirectMode = "inline"
+
+	// redirectParam is the query parameter that overrides the configured redirect mode
+	redirectParam = "redirect"
+)
+
+// ParseRedirectMode returns the RedirectMode named by s
+func ParseRedirectMode(s string) (RedirectMode, error) {
+	switch mode := RedirectMode(s); mode {
+	case RedirectMovedPermanently, RedirectPermanent, RedirectInline:
+		return mode, nil
+	}
+	return "", fmt.Errorf("unknown redirect mode %q, expected one of %s, %s or %s", s, RedirectMovedPermanently, RedirectPermanent, RedirectInline)
+}
+
+// status is the status code a redirect is served with
+func (m RedirectMode) status() int {
+	if m == RedirectPermanent {
+		return http.StatusPermanentRedirect
+	}
+	return http.StatusMovedPermanently
+}
+
+// redirectModeFor returns the redirect mode requested by r, falling back to the configured one
+func (h *Handler) redirectModeFor(r *http.Request) (RedirectMode, error) {
+	values, ok := r.URL.Query()[redirectParam]
+	if !ok {
+		return h.redirectMode, nil
+	}
+	if len(values) != 1 {
+		return "", fmt.Errorf("%s must be given once", redirectParam)
+	}
+	return ParseRedirectMode(values[0])
+}
@@ -0,0 +1,96 @@
+package people
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/Financial-Times/go-logger"
+)
+
+const maxRetryBackoff = 2 * time.Second
+
+// retryPolicy describes how failed requests to the concepts API are retried.
+// Only GET requests are retried, and only on connection errors or a 502, 503 or 504.
+type retryPolicy struct {
+	retries int
+	backoff time.Duration
+}
+
+// backoffFor returns the jittered delay before the given retry, counting from 0.
+// The delay doubles with each retry up to maxRetryBackoff, and is randomised
+// over its upper half so that retries from many requests do not line up.
+// Without a backoff, failed requests are retried straight away.
+func (p retryPolicy) backoffFor(retry int) time.Duration {
+	if p.backoff <= 0 {
+		return 0
+	}
+	d := p.backoff << uint(retry)
+	if retry >= 62 || d < p.backoff || d > maxRetryBackoff {
+		d = maxRetryBackoff
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// do sends req to the concepts API, retrying and tripping the circuit breaker as needed.
+// The circuit breaker counts a request once, however many times it is retried.
+func (h *Handler) do(req *http.Request, tid string) (*http.Response, error) {
+	retries := h.retry.retries
+	if req.Method != http.MethodGet {
+		retries = 0
+	}
+
+	if err := h.breaker.allow(); err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := h.client.Do(req)
+		if err != nil && req.Context().Err() == context.Canceled {
+			h.breaker.cancelled()
+			return nil, err
+		}
+		failed := err != nil || isRetryableStatus(resp.StatusCode)
+		if !failed {
+			h.breaker.success()
+			return resp, nil
+		}
+
+		if attempt >= retries {
+			h.breaker.failure()
+			return resp, err
+		}
+
+		entry := logger.WithTransactionID(tid).WithField("attempt", attempt+1)
+		if err != nil {
+			entry.WithError(err).Warn("Request to public-concepts-api failed, retrying")
+		} else {
+			entry.WithField("status", resp.StatusCode).Warn("Request to public-concepts-api failed, retrying")
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-time.After(h.retry.backoffFor(attempt)):
+		case <-req.Context().Done():
+			if req.Context().Err() == context.Canceled {
+				h.breaker.cancelled()
+			} else {
+				h.breaker.failure()
+			}
+			return nil, req.Context().Err()
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package people
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Financial-Times/go-logger"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/jarcoal/httpmock.v1"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := retryPolicy{retries: 10, backoff: 100 * time.Millisecond}
+
+	for retry, max := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond} {
+		d := p.backoffFor(retry)
+		assert.True(t, d >= max/2 && d <= max, "backoff %v for retry %d should be between %v and %v", d, retry, max/2, max)
+	}
+
+	for _, retry := range []int{5, 10, 70} {
+		d := p.backoffFor(retry)
+		assert.True(t, d >= maxRetryBackoff/2 && d <= maxRetryBackoff, "backoff %v for retry %d should be capped", d, retry)
+	}
+}
+
+func TestRetryPolicy_NoBackoff(t *testing.T) {
+	p := retryPolicy{retries: 10}
+
+	for _, retry := range []int{0, 1, 5, 70} {
+		assert.Equal(t, time.Duration(0), p.backoffFor(retry), "retry %d should not wait", retry)
+	}
+}
+
+func TestDo_CancelledTrialReleasesBreaker(t *testing.T) {
+	logger.InitDefaultLogger("retry-test")
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	h := NewHandler(HandlerConfig{BreakerThreshold: 1, BreakerCooldown: time.Minute}, http.DefaultClient)
+	now := time.Now()
+	h.breaker.now = func() time.Time { return now }
+	h.breaker.failure()
+	now = now.Add(2 * time.Minute)
+
+	httpmock.RegisterResponder("GET", "http://localhost:8080/concepts", func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("X-Request-Id") == "tid_cancelled" {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}
+		return httpmock.NewStringResponse(http.StatusOK, "{}"), nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequest("GET", "http://localhost:8080/concepts", nil)
+	req.Header.Set("X-Request-Id", "tid_cancelled")
+	go cancel()
+	_, err := h.do(req.WithContext(ctx), "tid_cancelled")
+	assert.Error(t, err)
+
+	req, _ = http.NewRequest("GET", "http://localhost:8080/concepts", nil)
+	resp, err := h.do(req, "tid_test")
+	assert.NoError(t, err, "the request after a cancelled trial should be let through as the trial")
+	resp.Body.Close()
+	_, err = h.breaker.Checker()
+	assert.NoError(t, err)
+	assert.Equal(t, breakerClosed, h.breaker.state)
+}
@@ -0,0 +1,207 @@
+package people
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	searchParam = "q"
+
+	maxSearchQueryLength = 256
+)
+
+// Searcher finds the people whose names match a query. How names are matched is up to
+// implementations, which return every match they find in any order. The handler ranks
+// and paginates the matches, and serves all of them.
+type Searcher interface {
+	SearchPeople(ctx context.Context, query, tid string) ([]Person, error)
+}
+
+// parseSearchQuery reads the q query parameter, reporting whether it was given
+func parseSearchQuery(query url.Values) (string, bool, error) {
+	values, ok := query[searchParam]
+	if !ok {
+		return "", false, nil
+	}
+	if len(values) > 1 {
+		return "", true, fmt.Errorf("%s can only be given once", searchParam)
+	}
+	q := strings.TrimSpace(values[0])
+	if q == "" {
+		return "", true, fmt.Errorf("%s must not be empty", searchParam)
+	}
+	if utf8.RuneCountInString(q) > maxSearchQueryLength {
+		return "", true, fmt.Errorf("%s must be no longer than %d characters", searchParam, maxSearchQueryLength)
+	}
+	return q, true, nil
+}
+
+// searchTokens splits a query or a name into lower case words
+func searchTokens(s string) []string {
+	return strings.Fields(strings.ToLower(s))
+}
+
+// How well a name matches a query, best first
+const (
+	scoreNone = iota
+	scoreSubstring
+	scoreWordPrefix
+	scorePrefix
+	scoreExact
+)
+
+// nameScore scores how well name matches the query tokens. Every token has to appear
+// somewhere in the name for it to match at all.
+func nameScore(name string, tokens []string) int {
+	name = strings.ToLower(name)
+	query := strings.Join(tokens, " ")
+	words := searchTokens(name)
+	if len(tokens) == 0 {
+		return scoreNone
+	}
+	if strings.Join(words, " ") == query {
+		return scoreExact
+	}
+
+	score := scoreWordPrefix
+	for _, token := range tokens {
+		if !strings.Contains(name, token) {
+			return scoreNone
+		}
+		if !hasWordWithPrefix(words, token) {
+			score = scoreSubstring
+		}
+	}
+	if score == scoreWordPrefix && strings.HasPrefix(strings.Join(words, " "), query) {
+		return scorePrefix
+	}
+	return score
+}
+
+func hasWordWithPrefix(words []string, prefix string) bool {
+	for _, word := range words {
+		if strings.HasPrefix(word, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// personScore is the best score of the names of p. A match on the preferred label ranks
+// above an equally good match on one of the other labels.
+func personScore(p Person, tokens []string) int {
+	best := nameScore(p.PrefLabel, tokens) * 2
+	for _, label := range p.Labels {
+		if score := nameScore(label, tokens)*2 - 1; score > best {
+			best = score
+		}
+	}
+	return best
+}
+
+// rankPeople orders people by how well their names match the query, then alphabetically by
+// preferred label, then by ID so that pages are stable. People matched by the searcher in
+// ways not scored here, such as ignoring accents, are kept after every scored match.
+func rankPeople(people []Person, query string) []Thing {
+	tokens := searchTokens(query)
+
+	type scored struct {
+		thing Thing
+		score int
+	}
+	var matches []scored
+	seen := make(map[string]bool, len(people))
+	for _, p := range people {
+		if seen[p.ID] {
+			continue
+		}
+		seen[p.ID] = true
+		matches = append(matches, scored{p.Thing, personScore(p, tokens)})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		if la, lb := strings.ToLower(a.thing.PrefLabel), strings.ToLower(b.thing.PrefLabel); la != lb {
+			return la < lb
+		}
+		return a.thing.ID < b.thing.ID
+	})
+
+	things := make([]Thing, len(matches))
+	for i, m := range matches {
+		things[i] = m.thing
+	}
+	return things
+}
+
+// conceptsSearcher searches people with the search mode of the concepts API. It makes a single
+// search, so finds no more people than the concepts API returns for one.
+type conceptsSearcher struct {
+	h *Handler
+}
+
+// searchResponse is the body of a search of the concepts API
+type searchResponse struct {
+	Concepts []Concept `json:"concepts"`
+}
+
+func (s conceptsSearcher) SearchPeople(ctx context.Context, query, tid string) ([]Person, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("type", ftPersonType)
+	params.Set("mode", "search")
+
+	var resp searchResponse
+	err := s.h.getFromConceptsAPI(ctx, "/concepts", params, "", tid, &resp)
+	if errors.Is(err, errNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	people := make([]Person, 0, len(resp.Concepts))
+	for _, c := range resp.Concepts {
+		if c.ID == "" {
+			continue
+		}
+		var p Person
+		convertToPerson(c, &p)
+		people = append(people, p)
+	}
+	return people, nil
+}
+
+// MemorySearcher is a Searcher over a fixed set of people, for tests and local development
+type MemorySearcher struct {
+	people []Person
+}
+
+// NewMemorySearcher returns a Searcher over the given people
+func NewMemorySearcher(people ...Person) *MemorySearcher {
+	return &MemorySearcher{people: people}
+}
+
+// SearchPeople returns the people with a name containing every word of the query, ignoring case
+func (s *MemorySearcher) SearchPeople(ctx context.Context, query, tid string) ([]Person, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	tokens := searchTokens(query)
+	var matches []Person
+	for _, p := range s.people {
+		if personScore(p, tokens) > 0 {
+			matches = append(matches, p)
+		}
+	}
+	return matches, nil
+}
@@ -0,0 +1,97 @@
+package people
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func searchPerson(id, prefLabel string, labels ...string) Person {
+	return Person{Thing: Thing{ID: "http://api.ft.com/things/" + id, PrefLabel: prefLabel}, Labels: labels}
+}
+
+func TestParseSearchQuery(t *testing.T) {
+	q, ok, err := parseSearchQuery(url.Values{})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Empty(t, q)
+
+	q, ok, err = parseSearchQuery(url.Values{"q": {"  Neil Cole "}})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "Neil Cole", q)
+
+	q, ok, err = parseSearchQuery(url.Values{"q": {strings.Repeat("ë", maxSearchQueryLength)}})
+	assert.NoError(t, err, "the length should be counted in characters, not bytes")
+	assert.True(t, ok)
+	assert.Equal(t, strings.Repeat("ë", maxSearchQueryLength), q)
+
+	for _, values := range [][]string{{""}, {"  "}, {"neil", "cole"}, {strings.Repeat("ë", maxSearchQueryLength+1)}} {
+		_, ok, err = parseSearchQuery(url.Values{"q": values})
+		assert.True(t, ok)
+		assert.Error(t, err, values)
+	}
+}
+
+func TestNameScore(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		score int
+	}{
+		{"Neil Cole", "neil cole", scoreExact},
+		{"Neil  Cole", "NEIL COLE", scoreExact},
+		{"Neil Cole", "neil c", scorePrefix},
+		{"Neil Cole", "cole", scoreWordPrefix},
+		{"Neil Cole", "cole neil", scoreWordPrefix},
+		{"Neil Coleman", "eil", scoreSubstring},
+		{"Neil Cole", "neil smith", scoreNone},
+		{"Neil Cole", "", scoreNone},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.score, nameScore(test.name, searchTokens(test.query)), "%q for %q", test.name, test.query)
+	}
+}
+
+func TestRankPeople(t *testing.T) {
+	people := []Person{
+		searchPerson("4", "Ann Colebrook"),
+		searchPerson("3", "Nicole Smith"),
+		searchPerson("2", "Jim Cole", "James Cole"),
+		searchPerson("5", "Cole"),
+		searchPerson("1", "Mary Jones", "Cole"),
+		searchPerson("6", "Zoë Colé"),
+		searchPerson("2", "Jim Cole"),
+	}
+
+	var labels []string
+	for _, thing := range rankPeople(people, "cole") {
+		labels = append(labels, thing.PrefLabel)
+	}
+	assert.Equal(t, []string{"Cole", "Mary Jones", "Ann Colebrook", "Jim Cole", "Nicole Smith", "Zoë Colé"}, labels,
+		"exact matches first, preferring the preferred label, then word prefixes alphabetically, then substrings, then the other matches of the searcher")
+}
+
+func TestMemorySearcher(t *testing.T) {
+	searcher := NewMemorySearcher(
+		searchPerson("1", "Neil Cole", "Neil Richard Cole"),
+		searchPerson("2", "Jane Doe"),
+		searchPerson("3", "Richard Roe"),
+	)
+
+	people, err := searcher.SearchPeople(context.Background(), "RICHARD", "tid_test")
+	assert.NoError(t, err)
+	assert.Len(t, people, 2)
+
+	people, err = searcher.SearchPeople(context.Background(), "jane roe", "tid_test")
+	assert.NoError(t, err)
+	assert.Empty(t, people)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = searcher.SearchPeople(ctx, "jane", "tid_test")
+	assert.Equal(t, context.Canceled, err)
+}
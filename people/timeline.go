@@ -0,0 +1,89 @@
+package people
+
+import (
+	"sort"
+)
+
+const (
+	timelineStarted = "started"
+	timelineEnded   = "ended"
+
+	timelineMembership = "membership"
+	timelineRole       = "role"
+)
+
+// buildTimeline flattens the change events of the memberships of p, and of their roles, into a
+// chronological list. Events whose date cannot be read keep their place after every dated event,
+// and memberships and roles without any change events are listed separately as undated.
+func buildTimeline(p Person) Timeline {
+	t := Timeline{Events: []TimelineEvent{}, Undated: []TimelineEvent{}}
+	for _, m := range p.Memberships {
+		org := m.Organisation.Thing
+		entry := TimelineEvent{Kind: timelineMembership, Title: m.Title, Organisation: org}
+		t.add(entry, m.ChangeEvents)
+
+		for _, r := range m.Roles {
+			role := r.Thing
+			entry := TimelineEvent{Kind: timelineRole, Title: m.Title, Organisation: org, Role: &role}
+			t.add(entry, r.ChangeEvents)
+		}
+	}
+
+	sort.SliceStable(t.Events, func(i, j int) bool {
+		a, aOk := parseEventDate(t.Events[i].Date)
+		b, bOk := parseEventDate(t.Events[j].Date)
+		if !aOk || !bOk {
+			return aOk && !bOk
+		}
+		return a.Before(b)
+	})
+	return t
+}
+
+// add appends an event to the timeline for each start and end in events. The latest start of a
+// period that has not ended since is marked as ongoing.
+func (t *Timeline) add(entry TimelineEvent, events []ChangeEvent) {
+	if len(events) == 0 {
+		t.Undated = append(t.Undated, entry)
+		return
+	}
+
+	first := len(t.Events)
+	latestStart := -1
+	for _, e := range events {
+		if e.StartedAt != "" {
+			start := entry
+			start.Event, start.Date, start.Precision = timelineStarted, e.StartedAt, e.StartedAtPrecision
+			if latestStart < 0 || laterEvent(start.Date, t.Events[latestStart].Date) {
+				latestStart = len(t.Events)
+			}
+			t.Events = append(t.Events, start)
+		}
+		if e.EndedAt != "" {
+			end := entry
+			end.Event, end.Date, end.Precision = timelineEnded, e.EndedAt, e.EndedAtPrecision
+			t.Events = append(t.Events, end)
+		}
+	}
+	if latestStart < 0 {
+		return
+	}
+
+	for _, e := range t.Events[first:] {
+		if e.Event == timelineEnded && !laterEvent(t.Events[latestStart].Date, e.Date) {
+			return
+		}
+	}
+	t.Events[latestStart].Ongoing = true
+}
+
+// laterEvent reports whether the change event date a is after b, treating dates that
+// cannot be read as earlier than any other
+func laterEvent(a, b string) bool {
+	at, aOk := parseEventDate(a)
+	bt, bOk := parseEventDate(b)
+	if !aOk || !bOk {
+		return aOk && !bOk
+	}
+	return at.After(bt)
+}
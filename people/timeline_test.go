@@ -0,0 +1,81 @@
+package people
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildTimeline(t *testing.T) {
+	ft := Thing{ID: "http://api.ft.com/things/ft", PrefLabel: "Financial Times"}
+	uni := Thing{ID: "http://api.ft.com/things/uni", PrefLabel: "University"}
+	editor := Thing{PrefLabel: "Editor"}
+	person := Person{
+		Memberships: []Membership{
+			{
+				Title:        "Editor",
+				Organisation: Organisation{Thing: ft},
+				ChangeEvents: []ChangeEvent{{StartedAt: "2005-06-01"}},
+				Roles: []Role{
+					{Thing: editor, ChangeEvents: []ChangeEvent{{StartedAt: "2005-06-01"}, {EndedAt: "2010-01-01", EndedAtPrecision: precisionYear}}},
+					{Thing: Thing{PrefLabel: "Member"}},
+				},
+			},
+			{
+				Title:        "Graduate Degree",
+				Organisation: Organisation{Thing: uni},
+				ChangeEvents: []ChangeEvent{{StartedAt: "1979-01-01", StartedAtPrecision: precisionYear}, {EndedAt: "1982-07-01", EndedAtPrecision: precisionMonth}},
+			},
+			{
+				Title:        "Adviser",
+				Organisation: Organisation{Thing: uni},
+				ChangeEvents: []ChangeEvent{{StartedAt: "sometime"}},
+			},
+			{
+				Title:        "Board Member",
+				Organisation: Organisation{Thing: ft},
+			},
+		},
+	}
+
+	timeline := buildTimeline(person)
+
+	assert.Equal(t, []TimelineEvent{
+		{Date: "1979-01-01", Precision: precisionYear, Event: timelineStarted, Kind: timelineMembership, Title: "Graduate Degree", Organisation: uni},
+		{Date: "1982-07-01", Precision: precisionMonth, Event: timelineEnded, Kind: timelineMembership, Title: "Graduate Degree", Organisation: uni},
+		{Date: "2005-06-01", Event: timelineStarted, Kind: timelineMembership, Title: "Editor", Organisation: ft, Ongoing: true},
+		{Date: "2005-06-01", Event: timelineStarted, Kind: timelineRole, Title: "Editor", Organisation: ft, Role: &editor},
+		{Date: "2010-01-01", Precision: precisionYear, Event: timelineEnded, Kind: timelineRole, Title: "Editor", Organisation: ft, Role: &editor},
+		{Date: "sometime", Event: timelineStarted, Kind: timelineMembership, Title: "Adviser", Organisation: uni, Ongoing: true},
+	}, timeline.Events)
+	assert.Equal(t, []TimelineEvent{
+		{Kind: timelineRole, Title: "Editor", Organisation: ft, Role: &Thing{PrefLabel: "Member"}},
+		{Kind: timelineMembership, Title: "Board Member", Organisation: ft},
+	}, timeline.Undated)
+}
+
+func TestBuildTimeline_Restarted(t *testing.T) {
+	person := Person{
+		Memberships: []Membership{{
+			Title:        "Director",
+			ChangeEvents: []ChangeEvent{{StartedAt: "2001-01-01"}, {EndedAt: "2005-01-01"}, {StartedAt: "2010-01-01"}},
+		}},
+	}
+
+	events := buildTimeline(person).Events
+	assert.Len(t, events, 3)
+	assert.False(t, events[0].Ongoing)
+	assert.True(t, events[2].Ongoing)
+
+	person.Memberships[0].ChangeEvents = append(person.Memberships[0].ChangeEvents, ChangeEvent{EndedAt: "2010-01-01"})
+	for _, e := range buildTimeline(person).Events {
+		assert.False(t, e.Ongoing, e.Date)
+	}
+}
+
+func TestBuildTimeline_NoMemberships(t *testing.T) {
+	timeline := buildTimeline(Person{})
+	assert.Empty(t, timeline.Events)
+	assert.NotNil(t, timeline.Events)
+	assert.NotNil(t, timeline.Undated)
+}
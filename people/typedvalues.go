@@ -0,0 +1,53 @@
+package people
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/Financial-Times/go-logger"
+	"github.com/rcrowley/go-metrics"
+)
+
+var skippedTypedValues = metrics.GetOrRegisterCounter("people.typedvalues.skipped", metrics.DefaultRegistry)
+
+// coerceString converts a scalar JSON value of an account or label to a string.
+// Numbers and booleans are written as they would be in JSON; null, arrays and objects cannot be converted.
+func coerceString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	return "", false
+}
+
+// coerceStrings converts the JSON value of a label to strings, taking each element of an array
+// as a label of its own. It reports false if any of the value had to be skipped.
+func coerceStrings(value interface{}) ([]string, bool) {
+	values, isList := value.([]interface{})
+	if !isList {
+		values = []interface{}{value}
+	}
+
+	var coerced []string
+	ok := true
+	for _, v := range values {
+		s, valid := coerceString(v)
+		if !valid {
+			ok = false
+			continue
+		}
+		coerced = append(coerced, s)
+	}
+	return coerced, ok
+}
+
+func skipTypedValue(conceptID string, value TypedValue) {
+	logger.WithField("uuid", conceptID).WithField("type", value.Type).Warnf("Skipping %T value that cannot be converted to a string", value.Value)
+	skippedTypedValues.Inc(1)
+}
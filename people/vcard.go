@@ -0,0 +1,111 @@
+package people
+
+import (
+	"bytes"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	mediaTypeVCard = "text/vcard"
+	vcardExtension = "vcf"
+
+	// vcardLineLength is the number of octets after which lines are folded, as RFC 6350 recommends
+	vcardLineLength = 75
+)
+
+var (
+	vcardTextEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, ";", `\;`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+	vcardLineBreaks  = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ")
+)
+
+// toVCard writes p as a vCard 4.0, giving the organisation and title of its current membership on date.
+// People only have a single name, so the last word of it is taken to be their family name.
+func toVCard(p Person, date time.Time) []byte {
+	var card vcard
+	card.line("BEGIN:VCARD")
+	card.line("VERSION:4.0")
+	card.line("UID:urn:uuid:" + vcardLineBreaks.Replace(strings.TrimPrefix(p.ID, urlPrefix)))
+	card.line("FN:" + vcardText(p.PrefLabel))
+
+	given, family := "", strings.TrimSpace(p.PrefLabel)
+	if i := strings.LastIndex(family, " "); i >= 0 {
+		given, family = strings.TrimSpace(family[:i]), family[i+1:]
+	}
+	card.line("N:" + strings.Join([]string{vcardText(family), vcardText(given), "", vcardText(p.Salutation), ""}, ";"))
+
+	if p.EmailAddress != "" {
+		card.line("EMAIL:" + vcardText(p.EmailAddress))
+	}
+	if p.TwitterHandle != "" {
+		card.line("X-SOCIALPROFILE;TYPE=twitter:" + vcardURI("https://twitter.com/"+strings.TrimPrefix(p.TwitterHandle, "@")))
+	}
+	if p.FacebookProfile != "" {
+		card.line("X-SOCIALPROFILE;TYPE=facebook:" + vcardURI(p.FacebookProfile))
+	}
+	if p.ImageURL != "" {
+		card.line("PHOTO:" + vcardURI(p.ImageURL))
+	}
+
+	if m, ok := currentMembership(p.Memberships, date); ok {
+		if m.Organisation.PrefLabel != "" {
+			card.line("ORG:" + vcardText(m.Organisation.PrefLabel))
+		}
+		if m.Title != "" {
+			card.line("TITLE:" + vcardText(m.Title))
+		}
+	}
+
+	card.line("END:VCARD")
+	return card.Bytes()
+}
+
+// currentMembership returns the most recently started of the memberships current on date
+func currentMembership(memberships []Membership, date time.Time) (Membership, bool) {
+	var current Membership
+	var currentStart time.Time
+	found := false
+	for _, m := range memberships {
+		if periodStatus(m.ChangeEvents, date) != membershipsCurrent {
+			continue
+		}
+		start, _ := membershipStart(m.ChangeEvents)
+		if !found || start.After(currentStart) {
+			current, currentStart, found = m, start, true
+		}
+	}
+	return current, found
+}
+
+// vcardText escapes a text value, or a component of a structured value such as N
+func vcardText(s string) string {
+	return vcardTextEscaper.Replace(s)
+}
+
+// vcardURI makes sure a URI value is kept on a single logical line
+func vcardURI(s string) string {
+	return vcardLineBreaks.Replace(s)
+}
+
+type vcard struct {
+	bytes.Buffer
+}
+
+// line writes a content line, folding it every vcardLineLength octets without splitting a UTF-8 sequence
+func (c *vcard) line(s string) {
+	limit := vcardLineLength
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		c.WriteString(s[:cut])
+		c.WriteString("\r\n ")
+		s = s[cut:]
+		// continuation lines start with a space, which counts towards their length
+		limit = vcardLineLength - 1
+	}
+	c.WriteString(s)
+	c.WriteString("\r\n")
+}
@@ -0,0 +1,115 @@
+package people
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestToVCard(t *testing.T) {
+	date := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		person Person
+	}{
+		{
+			name: "complete",
+			person: Person{
+				Thing:           Thing{ID: "http://api.ft.com/things/60e54253-1e94-38df-83b1-a39804d1ac18", PrefLabel: "Neil Cole"},
+				Salutation:      "Mr.",
+				EmailAddress:    "example@example.com",
+				TwitterHandle:   "@ft",
+				FacebookProfile: "https://www.facebook.com/financialtimes/",
+				ImageURL:        "https://www.ft.com/__origami/service/image/v2/images/raw/fthead-v1:merryn-somerset-webb?source=next",
+				Memberships: []Membership{
+					{
+						Title:        "Graduate Degree",
+						Organisation: Organisation{Thing: Thing{PrefLabel: "Maurice A. Deane School of Law at Hofstra University"}},
+						ChangeEvents: []ChangeEvent{{StartedAt: "1979-01-01"}, {EndedAt: "1982-01-01"}},
+					},
+					{
+						Title:        "Director",
+						Organisation: Organisation{Thing: Thing{PrefLabel: "Iconix Brand Group Inc"}},
+						ChangeEvents: []ChangeEvent{{StartedAt: "1993-01-01"}},
+					},
+					{
+						Title:        "Chairman",
+						Organisation: Organisation{Thing: Thing{PrefLabel: "Iconix Brand Group Inc"}},
+						ChangeEvents: []ChangeEvent{{StartedAt: "2011-05-01"}},
+					},
+					{
+						Title:        "Adviser",
+						Organisation: Organisation{Thing: Thing{PrefLabel: "Future Ventures"}},
+						ChangeEvents: []ChangeEvent{{StartedAt: "2020-01-01"}},
+					},
+				},
+			},
+		},
+		{
+			name: "escaping",
+			person: Person{
+				Thing:        Thing{ID: "http://api.ft.com/things/8ec028a9-a5e7-49ae-8bd5-7cd0a57df1d6", PrefLabel: "Smith, Jones; and\\or Partner"},
+				Salutation:   "Dr;",
+				EmailAddress: "first,last@example.com",
+				Memberships: []Membership{{
+					Title:        "Head of Research,\nEurope; Asia",
+					Organisation: Organisation{Thing: Thing{PrefLabel: "Marks & Spencer, plc; UK\\EU"}},
+				}},
+			},
+		},
+		{
+			name: "folding",
+			person: Person{
+				Thing: Thing{ID: "http://api.ft.com/things/0e9bd18b-5e0a-4f5f-a3ea-6fe7f4d4e0a1", PrefLabel: "Zoë Ångström-Ørsted"},
+				Memberships: []Membership{{
+					Title:        "Non-Executive Director and Chair of the Remuneration, Audit and Nomination Committees",
+					Organisation: Organisation{Thing: Thing{PrefLabel: "Société Générale de Surveillance Holding — Zürich Branch für Überwachung und Qualitätsprüfung"}},
+				}},
+			},
+		},
+		{
+			name:   "minimal",
+			person: Person{Thing: Thing{ID: "http://api.ft.com/things/9d4e354e-13dc-3287-8950-230f3c6416d4", PrefLabel: "Cher"}},
+		},
+	}
+
+	for _, test := range tests {
+		actual := toVCard(test.person, date)
+		golden := filepath.Join("testdata", "vcard", test.name+".vcf")
+		if *updateGolden {
+			assert.NoError(t, os.WriteFile(golden, actual, 0644), test.name)
+		}
+
+		expected, err := os.ReadFile(golden)
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, string(expected), string(actual), test.name)
+
+		for _, line := range strings.SplitAfter(string(actual), "\r\n") {
+			assert.True(t, len(line) <= vcardLineLength+2, "%s: line %q is too long", test.name, line)
+			assert.True(t, utf8.ValidString(line), "%s: line %q splits a character", test.name, line)
+		}
+	}
+}
+
+func TestCurrentMembership(t *testing.T) {
+	date := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	_, ok := currentMembership([]Membership{{Title: "Past", ChangeEvents: []ChangeEvent{{StartedAt: "2001-01-01"}, {EndedAt: "2005-01-01"}}}}, date)
+	assert.False(t, ok)
+
+	m, ok := currentMembership([]Membership{
+		{Title: "Undated"},
+		{Title: "Latest", ChangeEvents: []ChangeEvent{{StartedAt: "2010-01-01"}}},
+		{Title: "Earlier", ChangeEvents: []ChangeEvent{{StartedAt: "2005-01-01"}}},
+	}, date)
+	assert.True(t, ok)
+	assert.Equal(t, "Latest", m.Title)
+}